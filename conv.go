@@ -2,11 +2,16 @@
 package conv
 
 import (
+	"math/big"
+	"reflect"
 	"time"
 
+	"github.com/cstockton/go-conv/decimal"
 	"github.com/cstockton/go-conv/internal/refconv"
 )
 
+//go:generate go run gen.go
+
 var converter = refconv.Conv{}
 
 // Infer will perform conversion by inferring the conversion operation from
@@ -23,12 +28,151 @@ func Infer(into, from interface{}) error {
 	return converter.Infer(into, from)
 }
 
+// ValueOf returns a Value wrapping the given value, errors from its conversions
+// are returned by the Err method.
+func ValueOf(from interface{}) Value {
+	return converter.Value(from)
+}
+
+// Get returns the value found by following the path from root, which is
+// usually nested maps and slices such as those decoded from JSON. Segments of
+// the path are names separated by dots, indexes within brackets and keys quoted
+// within brackets. A wildcard "*" matches each element of a map, slice or
+// struct, when a path contains one the result is a []interface{} of every
+// value found.
+//
+// Example:
+//
+//   v, err := conv.Get(data, `servers[0]["host.name"]`)
+//   ports, err := conv.Get(data, `servers[*].port`)
+func Get(root interface{}, path string) (interface{}, error) {
+	return converter.Get(root, path)
+}
+
+// Guess will convert the given string to the first of the types int64, uint64,
+// float64, bool, time.Duration, time.Time or string which it matches, an empty
+// string is returned as nil.
+//
+// Example:
+//
+//   v, err := conv.Guess(`1h30m`)
+//   // v -> time.Duration(5400000000000)
+func Guess(s string) (interface{}, error) {
+	return converter.Guess(s)
+}
+
+// GuessColumn will convert each of the given strings to the most specific type
+// which all of them match, empty strings are returned as nil.
+//
+// Example:
+//
+//   v, err := conv.GuessColumn([]string{`1`, ``, `2.5`})
+//   // v -> []interface{}{float64(1), nil, float64(2.5)}
+func GuessColumn(col []string) ([]interface{}, error) {
+	return converter.GuessColumn(col)
+}
+
+// CanConvert returns true if some value of the type from may be converted to
+// the type to, without performing a conversion.
+//
+// Example:
+//
+//   ok := conv.CanConvert(reflect.TypeOf(""), reflect.TypeOf(0))
+//   // ok -> true
+func CanConvert(from, to reflect.Type) bool {
+	return converter.CanConvert(from, to)
+}
+
+// Check returns the error Infer would return when converting the given value
+// to the type into, without assigning the result anywhere.
+//
+// Example:
+//
+//   err := conv.Check(reflect.TypeOf(0), `abc`)
+//   // err -> cannot convert "abc" (type string) to int
+func Check(into reflect.Type, from interface{}) error {
+	return converter.Check(into, from)
+}
+
+// Matrix returns each pair of the common Go types for which CanConvert returns
+// true.
+func Matrix() []Conversion {
+	return converter.Matrix()
+}
+
+// Explain will perform the same conversion as Infer, returning a description
+// of each step taken such as the parsers tried or any clamping applied.
+//
+// Example:
+//
+//   var into int
+//   steps, err := conv.Explain(&into, `T`)
+//   // steps[2] -> "T" is not a number, parsed it as the bool true
+func Explain(into, from interface{}) ([]string, error) {
+	return converter.Explain(into, from)
+}
+
+// Exact will perform the same conversion as Infer, additionally reporting
+// whether it was lossy because the result does not hold the exact value of
+// from, such as when fractions are dropped or values are clamped.
+//
+// Example:
+//
+//   var into float32
+//   lossy, err := conv.Exact(&into, 0.1)
+//   // into -> 0.1, lossy -> true
+func Exact(into, from interface{}) (lossy bool, err error) {
+	return converter.Exact(into, from)
+}
+
+// BigFloat will convert the given value to a *big.Float, returns nil if a
+// conversion can not be made.
+func BigFloat(from interface{}) (*big.Float, error) {
+	return converter.BigFloat(from)
+}
+
+// BigInt will convert the given value to a *big.Int, returns nil if a
+// conversion can not be made.
+func BigInt(from interface{}) (*big.Int, error) {
+	return converter.BigInt(from)
+}
+
+// BigRat will convert the given value to a *big.Rat, returns nil if a
+// conversion can not be made.
+func BigRat(from interface{}) (*big.Rat, error) {
+	return converter.BigRat(from)
+}
+
 // Bool will convert the given value to a bool, returns the default value of
 // false if a conversion can not be made.
 func Bool(from interface{}) (bool, error) {
 	return converter.Bool(from)
 }
 
+// Bytes will convert the given value to a []byte, returns nil if a conversion
+// can not be made.
+func Bytes(from interface{}) ([]byte, error) {
+	return converter.Bytes(from)
+}
+
+// Complex64 will convert the given value to a complex64, returns the default
+// value of 0 if a conversion can not be made.
+func Complex64(from interface{}) (complex64, error) {
+	return converter.Complex64(from)
+}
+
+// Complex128 will convert the given value to a complex128, returns the default
+// value of 0 if a conversion can not be made.
+func Complex128(from interface{}) (complex128, error) {
+	return converter.Complex128(from)
+}
+
+// Decimal will convert the given value to a decimal.Decimal, returns the zero
+// value if a conversion can not be made.
+func Decimal(from interface{}) (decimal.Decimal, error) {
+	return converter.Decimal(from)
+}
+
 // Duration will convert the given value to a time.Duration, returns the default
 // value of 0ns if a conversion can not be made.
 func Duration(from interface{}) (time.Duration, error) {
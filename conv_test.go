@@ -1,6 +1,9 @@
 package conv
 
 import (
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"testing"
 
 	"github.com/cstockton/go-conv/internal/testconv"
@@ -8,6 +11,8 @@ import (
 
 func TestConv(t *testing.T) {
 	testconv.RunBoolTests(t, Bool)
+	testconv.RunComplex64Tests(t, Complex64)
+	testconv.RunComplex128Tests(t, Complex128)
 	testconv.RunDurationTests(t, Duration)
 	testconv.RunFloat32Tests(t, Float32)
 	testconv.RunFloat64Tests(t, Float64)
@@ -29,3 +34,60 @@ func TestConv(t *testing.T) {
 	// the example_test.go file.
 	testconv.RunReadmeTest(t, `example_test.go`, `conv_test.go`)
 }
+
+// Verifies the Or and Must variants generated by gen.go exist for each
+// conversion declared by convert.Converter.
+func TestGenerated(t *testing.T) {
+	fset := token.NewFileSet()
+	parse := func(path string) *ast.File {
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return f
+	}
+	funcs := func(f *ast.File) map[string]bool {
+		names := make(map[string]bool)
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok {
+				names[fn.Name.Name] = true
+			}
+		}
+		return names
+	}
+
+	iface := parse(`internal/convert/convert.go`).Scope.Lookup(`Converter`)
+	methods := iface.Decl.(*ast.TypeSpec).Type.(*ast.InterfaceType).Methods.List
+	pkg := funcs(parse(`or.go`))
+	ref := funcs(parse(`internal/refconv/or.go`))
+	val := funcs(parse(`internal/refconv/valueconv.go`))
+	for _, m := range methods {
+		name := m.Names[0].Name
+		if name == `Infer` {
+			continue
+		}
+		for _, exp := range []string{name + `Or`, `Must` + name} {
+			if !pkg[exp] || !ref[exp] {
+				t.Errorf(`missing generated %v, run go generate`, exp)
+			}
+		}
+		if !val[name] || !val[name+`Or`] {
+			t.Errorf(`missing generated Value.%v, run go generate`, name)
+		}
+	}
+
+	if got := Float64Or(`foo`, 1.5); got != 1.5 {
+		t.Errorf(`Float64Or exp 1.5; got %v`, got)
+	}
+	if got := MustUint8(`12`); got != 12 {
+		t.Errorf(`MustUint8 exp 12; got %v`, got)
+	}
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error(`MustBool exp panic`)
+			}
+		}()
+		MustBool(`foo`)
+	}()
+}
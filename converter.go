@@ -0,0 +1,220 @@
+package conv
+
+import "github.com/cstockton/go-conv/internal/refconv"
+
+// Converter performs conversions using the options set within its fields. The
+// zero value performs the same conversions as the package level functions and
+// like them is safe for use by multiple Goroutines.
+//
+// Example:
+//
+//   c := conv.Converter{Locale: conv.LocaleDE}
+//   f, err := c.Float64(`1.234.567,89`)
+//   // f -> 1234567.89
+type Converter = refconv.Conv
+
+// Value wraps a dynamic value such as one decoded from JSON, providing a method
+// for each conversion which records the first error that occurs rather than
+// returning it. Values returned by Index, Key, Slice and Map share the errors
+// of the Value they were derived from.
+//
+// Example:
+//
+//   v := conv.ValueOf(map[string]interface{}{"port": "8080"})
+//   port := v.Key(`port`).Int()
+//   // port -> 8080, v.Err() -> nil
+type Value = refconv.Value
+
+// PathError is returned by Get when a path is invalid or a segment of it can
+// not be found.
+type PathError = refconv.PathError
+
+// Conversion is a pair of types returned by Matrix.
+type Conversion = refconv.Conversion
+
+// Locale describes how numbers are written within strings for a region, the
+// zero value parses numbers using the strconv package.
+type Locale = refconv.Locale
+
+// Common locales for use with a Converter.
+var (
+	LocaleEN = refconv.LocaleEN // -1,234,567.89
+	LocaleDE = refconv.LocaleDE // -1.234.567,89
+	LocaleFR = refconv.LocaleFR // -1 234 567,89
+	LocaleCH = refconv.LocaleCH // -1'234'567.89
+)
+
+// Affixes describes the decorations such as percent signs, currency symbols and
+// accounting negatives that may surround numeric strings.
+type Affixes = refconv.Affixes
+
+// PercentMode controls how a trailing percent sign is handled.
+type PercentMode = refconv.PercentMode
+
+// Percent modes for use within Affixes.
+const (
+	PercentNone  = refconv.PercentNone  // "45%" is rejected
+	PercentScale = refconv.PercentScale // "45%" is 0.45
+	PercentKeep  = refconv.PercentKeep  // "45%" is 45
+)
+
+// SuffixMode controls which magnitude suffixes such as "2.5k" or "512MiB" are
+// accepted at the end of numeric strings.
+type SuffixMode = refconv.SuffixMode
+
+// Suffix modes for use with a Converter.
+const (
+	SuffixNone        = refconv.SuffixNone        // "10k" is rejected
+	SuffixSI          = refconv.SuffixSI          // "10k" is 1e4, "1Ki" is 1024
+	SuffixBytes       = refconv.SuffixBytes       // "10KB" is 1e4, "1KiB" is 1024
+	SuffixBytesBinary = refconv.SuffixBytesBinary // "10KB" is 10240
+)
+
+// RoundingMode controls how fractional numbers are converted to integers.
+type RoundingMode = refconv.RoundingMode
+
+// Rounding modes for use with a Converter.
+const (
+	RoundTruncate = refconv.RoundTruncate // 2.9 is 2, -2.9 is -2
+	RoundError    = refconv.RoundError    // 2.9 is an error, 2.0 is 2
+	RoundFloor    = refconv.RoundFloor    // 2.9 is 2, -2.1 is -3
+	RoundCeil     = refconv.RoundCeil     // 2.1 is 3, -2.9 is -2
+	RoundHalfUp   = refconv.RoundHalfUp   // 2.5 is 3, -2.5 is -3
+	RoundHalfEven = refconv.RoundHalfEven // 2.5 is 2, 3.5 is 4
+)
+
+// NonFiniteMode controls how NaN and infinite values are converted.
+type NonFiniteMode = refconv.NonFiniteMode
+
+// Non-finite modes for use with a Converter.
+const (
+	NonFiniteDefault  = refconv.NonFiniteDefault  // behavior of each target
+	NonFiniteError    = refconv.NonFiniteError    // NaN and +/-Inf are errors
+	NonFiniteZero     = refconv.NonFiniteZero     // NaN and +/-Inf are zero
+	NonFiniteSaturate = refconv.NonFiniteSaturate // +/-Inf are max or min
+	NonFinitePass     = refconv.NonFinitePass     // kept for float targets
+)
+
+// Encoding selects how strings are decoded into bytes and bytes are encoded
+// into strings.
+type Encoding = refconv.Encoding
+
+// Encodings for use with a Converter, shown encoding the bytes "hi?".
+const (
+	EncodingNone         = refconv.EncodingNone         // "hi?"
+	EncodingHex          = refconv.EncodingHex          // "68693f"
+	EncodingBase64       = refconv.EncodingBase64       // "aGk/"
+	EncodingBase64URL    = refconv.EncodingBase64URL    // "aGk_"
+	EncodingBase64Raw    = refconv.EncodingBase64Raw    // "aGk/" without padding
+	EncodingBase64RawURL = refconv.EncodingBase64RawURL // "aGk_" without padding
+	EncodingBase32       = refconv.EncodingBase32       // "NBUT6==="
+)
+
+// UTF8Mode controls how bytes which are not valid UTF-8 are converted to
+// strings.
+type UTF8Mode = refconv.UTF8Mode
+
+// UTF-8 modes for use with a Converter.
+const (
+	UTF8Default = refconv.UTF8Default // slices cast, arrays formatted
+	UTF8Pass    = refconv.UTF8Pass    // slices and arrays cast as is
+	UTF8Replace = refconv.UTF8Replace // invalid bytes become U+FFFD
+	UTF8Error   = refconv.UTF8Error   // invalid bytes are an error
+)
+
+// NormForm selects the Unicode normalization form applied to strings.
+type NormForm = refconv.NormForm
+
+// Normalization forms for use with a Converter.
+const (
+	NormNone = refconv.NormNone // unchanged
+	NormNFC  = refconv.NormNFC  // canonical composition
+	NormNFKC = refconv.NormNFKC // compatibility composition
+)
+
+// BinaryMode controls whether byte slices and arrays are decoded as binary
+// numbers, and how Bytes encodes numbers.
+type BinaryMode = refconv.BinaryMode
+
+// Binary modes for use with a Converter.
+const (
+	BinaryNone         = refconv.BinaryNone         // bytes convert to length
+	BinaryBigEndian    = refconv.BinaryBigEndian    // [2]byte{1, 0} is 256
+	BinaryLittleEndian = refconv.BinaryLittleEndian // [2]byte{1, 0} is 1
+	BinaryVarint       = refconv.BinaryVarint       // []byte{0xac, 0x02} is 300
+)
+
+// LengthMode controls how arrays, slices, maps and channels are converted to
+// scalar values.
+type LengthMode = refconv.LengthMode
+
+// Length modes for use with a Converter.
+const (
+	LengthDefault = refconv.LengthDefault // []string{"42"} is 1
+	LengthUnwrap  = refconv.LengthUnwrap  // []string{"42"} is 42
+	LengthError   = refconv.LengthError   // []string{"42"} is an error
+)
+
+// EmptyMode controls how nil values, empty strings and the literals "null",
+// "nil" and "~" are converted.
+type EmptyMode = refconv.EmptyMode
+
+// Empty modes for use with a Converter.
+const (
+	EmptyDefault = refconv.EmptyDefault // behavior of each target
+	EmptyError   = refconv.EmptyError   // empty values are errors
+	EmptyZero    = refconv.EmptyZero    // empty values are zero
+	EmptyKeep    = refconv.EmptyKeep    // Infer leaves the target unchanged
+)
+
+// Preprocessor transforms a string before it is parsed by a Converter, an
+// error stops the conversion.
+type Preprocessor = refconv.Preprocessor
+
+// Preprocessors for use within the Preprocess chain of a Converter.
+//
+// Example:
+//
+//   c := conv.Converter{Preprocess: []conv.Preprocessor{
+//     conv.TrimSpace, conv.TrimQuotes, conv.ExpandEnv(nil)}}
+//   i, err := c.Int(` "${PORT}" `)
+//   // i -> 8080
+var (
+	TrimSpace  = refconv.TrimSpace  // " 42 " is "42"
+	TrimQuotes = refconv.TrimQuotes // `"true"` and "'5s'" are unquoted
+	FoldCase   = refconv.FoldCase   // "TRUE" is "true"
+	ExpandEnv  = refconv.ExpandEnv  // "${PORT}" is looked up
+)
+
+// Error is returned by conversions which fail, Reason holds the cause when one
+// is known and may be compared against ErrSyntax, ErrUnsupported and
+// strconv.ErrRange by using errors.Is.
+//
+// Example:
+//
+//   c := conv.Converter{Strict: true}
+//   _, err := c.Int(`3.99`)
+//   // errors.Is(err, conv.ErrSyntax) -> true
+type Error = refconv.Error
+
+// Reasons for the errors returned by a Converter with Strict set.
+var (
+	ErrSyntax      = refconv.ErrSyntax      // "yes" as an int
+	ErrUnsupported = refconv.ErrUnsupported // true as an int
+)
+
+// GuessType is a set of the types which may be returned by Guess and
+// GuessColumn, a Converter may restrict them by setting GuessTypes.
+type GuessType = refconv.GuessType
+
+// Guess types for use with a Converter, tried in the order below.
+const (
+	GuessInt      = refconv.GuessInt      // "42" is int64(42)
+	GuessUint     = refconv.GuessUint     // "18446744073709551615" is uint64
+	GuessFloat    = refconv.GuessFloat    // "1.5" is float64(1.5)
+	GuessBool     = refconv.GuessBool     // "true" is true
+	GuessDuration = refconv.GuessDuration // "1m" is time.Minute
+	GuessTime     = refconv.GuessTime     // "2006-01-02T15:04:05Z" is time.Time
+	GuessString   = refconv.GuessString   // "foo" is "foo"
+	GuessAll      = refconv.GuessAll      // every type
+)
@@ -0,0 +1,63 @@
+// Package decimal provides a fixed-point decimal number for lossless
+// conversion of values such as money.
+package decimal
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is a fixed-point decimal number with the value Coef * 10^-Scale, so
+// Decimal{Coef: 1234, Scale: 2} is 12.34. The zero value is 0.
+type Decimal struct {
+	Coef  int64
+	Scale int32
+}
+
+// Rat returns the exact value of d as a *big.Rat, the time and memory taken
+// grow with the magnitude of Scale.
+func (d Decimal) Rat() *big.Rat {
+	r := new(big.Rat).SetInt64(d.Coef)
+	if d.Scale == 0 {
+		return r
+	}
+
+	scale := int64(d.Scale)
+	if scale < 0 {
+		scale = -scale
+	}
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(scale), nil)
+	if d.Scale > 0 {
+		return r.Quo(r, new(big.Rat).SetInt(pow))
+	}
+	return r.Mul(r, new(big.Rat).SetInt(pow))
+}
+
+// maxPadding is the largest number of zeros String will pad a Decimal with,
+// beyond it the scale is written as an exponent instead.
+const maxPadding = 1000
+
+// String returns the exact decimal representation of d, i.e. "-12.34". When d
+// would need more than 1000 zeros of padding it is written with an exponent,
+// i.e. "1e-5000".
+func (d Decimal) String() string {
+	s := strconv.FormatInt(d.Coef, 10)
+	if d.Scale <= 0 && d.Coef == 0 {
+		return s
+	} else if d.Scale < -maxPadding || d.Scale > maxPadding {
+		return s + "e" + strconv.FormatInt(-int64(d.Scale), 10)
+	} else if d.Scale <= 0 {
+		return s + strings.Repeat("0", int(-d.Scale))
+	}
+
+	var neg string
+	if d.Coef < 0 {
+		neg, s = "-", s[1:]
+	}
+	if n := int(d.Scale); len(s) <= n {
+		s = strings.Repeat("0", n-len(s)+1) + s
+	}
+	point := len(s) - int(d.Scale)
+	return neg + s[:point] + "." + s[point:]
+}
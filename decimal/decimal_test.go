@@ -0,0 +1,44 @@
+package decimal
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		d   Decimal
+		str string
+		rat string
+	}{
+		{Decimal{}, "0", "0"},
+		{Decimal{Coef: 0, Scale: 2}, "0.00", "0"},
+		{Decimal{Coef: 0, Scale: -2}, "0", "0"},
+		{Decimal{Coef: 1234, Scale: 2}, "12.34", "617/50"},
+		{Decimal{Coef: -1234, Scale: 2}, "-12.34", "-617/50"},
+		{Decimal{Coef: 5, Scale: 3}, "0.005", "1/200"},
+		{Decimal{Coef: -5, Scale: 1}, "-0.5", "-1/2"},
+		{Decimal{Coef: 12, Scale: 0}, "12", "12"},
+		{Decimal{Coef: 12, Scale: -3}, "12000", "12000"},
+		{Decimal{Coef: -12, Scale: -1}, "-120", "-120"},
+		{Decimal{Coef: 1, Scale: -1000}, "1" + strings.Repeat("0", 1000), ""},
+		{Decimal{Coef: 1, Scale: -1001}, "1e1001", ""},
+		{Decimal{Coef: -15, Scale: 1001}, "-15e-1001", ""},
+		{Decimal{Coef: 1, Scale: math.MinInt32}, "1e2147483648", ""},
+		{Decimal{Coef: 1, Scale: math.MaxInt32}, "1e-2147483647", ""},
+		{Decimal{Coef: 0, Scale: math.MinInt32}, "0", ""},
+		{Decimal{Coef: 0, Scale: math.MaxInt32}, "0e-2147483647", ""},
+	}
+	for _, test := range tests {
+		if got := test.d.String(); got != test.str {
+			t.Errorf("%#v.String() exp %v; got %v", test.d, test.str, got)
+		}
+		if test.rat == "" {
+			continue
+		}
+		if got := test.d.Rat().RatString(); got != test.rat {
+			t.Errorf("%#v.Rat() exp %v; got %v", test.d, test.rat, got)
+		}
+	}
+}
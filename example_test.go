@@ -1,8 +1,12 @@
 package conv_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
+	"reflect"
+	"strings"
 	"time"
 
 	conv "github.com/cstockton/go-conv"
@@ -32,11 +36,18 @@ func Example() {
 	err := conv.Infer(&val, `42`)
 	fmt.Println(val, err) // 42, nil
 
+	// Each conversion has an Or variant returning a default value on failure,
+	// and a Must variant which panics for use when initializing variables.
+	fmt.Println(conv.IntOr("Foo", 8080))    // 8080
+	fmt.Println(conv.MustDuration(`1m30s`)) // 1m30s
+
 	// Output:
 	// 0 cannot convert "Foo" (type string) to int
 	// 42 <nil>
 	// 42 <nil>
 	// 42 <nil>
+	// 8080
+	// 1m30s
 }
 
 // Bool conversion supports all the paths provided by the standard libraries
@@ -188,10 +199,16 @@ func ExampleString() {
 	// String conversion from types that do not have a valid conversion path will
 	// still have sane string conversion for troubleshooting.
 	fmt.Println(conv.String(struct{ msg string }{"Foo"}))
+
+	// A Converter may repair or reject bytes which are not valid UTF-8 and
+	// normalize the resulting strings.
+	c := conv.Converter{UTF8: conv.UTF8Replace, Normalization: conv.NormNFKC}
+	fmt.Println(c.String([]byte("\ufb01le\xff")))
 	// Output:
 	// Foo <nil>
 	// Foo <nil>
 	// {Foo} <nil>
+	// file� <nil>
 }
 
 // Time conversion from other time values will be returned without modification.
@@ -256,6 +273,319 @@ func ExampleUint() {
 	// 255 <nil>
 }
 
+// BigInt conversion accepts numeric strings of any length along with all the
+// numeric types, the math/big types are also accepted as sources by the other
+// numeric conversions with range checking.
+func ExampleBigInt() {
+
+	fmt.Println(conv.BigInt("123456789012345678901234567890"))
+	fmt.Println(conv.BigInt(12.9))
+
+	i, _ := conv.BigInt("9223372036854775808")
+	fmt.Println(conv.Int64(i))
+	fmt.Println(conv.Float64(big.NewRat(1, 4)))
+	// Output:
+	// 123456789012345678901234567890 <nil>
+	// 12 <nil>
+	// 0 cannot convert 9223372036854775808 (type *big.Int) to int64: value out of range
+	// 0.25 <nil>
+}
+
+// Complex128 conversion parses strings in the form accepted by the standard
+// libraries strconv.ParseComplex, real numbers are given an imaginary part of
+// zero.
+func ExampleComplex128() {
+
+	fmt.Println(conv.Complex128("1+2i"))
+	fmt.Println(conv.Complex128("-1.5"))
+	fmt.Println(conv.Complex128(int64(3)))
+
+	// Converting complex numbers to real numbers drops the imaginary part unless
+	// StrictImaginary is set.
+	c := conv.Converter{StrictImaginary: true}
+	fmt.Println(conv.Int(2 + 3i))
+	fmt.Println(c.Int(2 + 3i))
+	// Output:
+	// (1+2i) <nil>
+	// (-1.5+0i) <nil>
+	// (3+0i) <nil>
+	// 2 <nil>
+	// 0 cannot convert (2+3i) (type complex128) to int: value has a non-zero imaginary part
+}
+
+// Decimal conversion produces a fixed-point decimal.Decimal without the binary
+// rounding error of floats, values which can not be represented exactly are
+// rejected unless a DecimalScale is set on a Converter.
+func ExampleDecimal() {
+
+	fmt.Println(conv.Decimal("12.34"))
+	fmt.Println(conv.Decimal(0.1))
+	fmt.Println(conv.Decimal(math.Inf(1)))
+
+	c := conv.Converter{DecimalScale: 2, Rounding: conv.RoundHalfEven}
+	fmt.Println(c.Decimal(big.NewRat(1, 3)))
+	fmt.Println(c.Decimal("0.125"))
+	// Output:
+	// 12.34 <nil>
+	// 0.1 <nil>
+	// 0 cannot convert +Inf (type float64) to decimal.Decimal: value is not a finite number
+	// 0.33 <nil>
+	// 0.12 <nil>
+}
+
+// Bytes conversion copies byte slices and arrays, reads an io.Reader until EOF
+// and casts strings. A Converter may set an Encoding to decode strings instead,
+// which String will then use to encode bytes.
+func ExampleBytes() {
+
+	fmt.Println(conv.Bytes("hi"))
+	fmt.Println(conv.Bytes(strings.NewReader("hi")))
+	fmt.Println(conv.Bytes([2]byte{'h', 'i'}))
+
+	c := conv.Converter{Encoding: conv.EncodingBase64}
+	fmt.Println(c.Bytes("aGk="))
+	fmt.Println(c.String([]byte("hi")))
+	// Output:
+	// [104 105] <nil>
+	// [104 105] <nil>
+	// [104 105] <nil>
+	// [104 105] <nil>
+	// aGk= <nil>
+}
+
+// Value wraps dynamic values such as those decoded from JSON, each conversion
+// records the first error so a group of them may be checked once with Err.
+func ExampleValue() {
+
+	v := conv.ValueOf(map[string]interface{}{
+		"port":    "8080",
+		"timeout": "5s",
+		"hosts":   []interface{}{"a", "b"},
+	})
+	fmt.Println(v.Key("port").Int())
+	fmt.Println(v.Key("timeout").Duration())
+	fmt.Println(v.Key("hosts").Index(1).String())
+	fmt.Println(v.Key("retries").IntOr(3))
+	fmt.Println(v.Err())
+
+	// The first error is kept when a conversion fails.
+	fmt.Println(v.Key("hosts").Index(2).String())
+	fmt.Println(v.Key("port").Bool())
+	fmt.Println(v.Err())
+	// Output:
+	// 8080
+	// 5s
+	// b
+	// 3
+	// <nil>
+	//
+	// false
+	// index 2 out of range for []interface {}{"a", "b"} (type []interface {})
+}
+
+// Get follows a path into nested maps and slices, the result may then be given
+// to any conversion.
+func ExampleGet() {
+
+	var data interface{}
+	err := json.Unmarshal([]byte(`{
+		"servers": [{"host": "a", "port": "80"}, {"host": "b", "port": 8080}],
+		"labels": {"app.kubernetes.io/name": "web"}
+	}`), &data)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(conv.Get(data, `labels["app.kubernetes.io/name"]`))
+	fmt.Println(conv.Get(data, `servers[*].host`))
+	fmt.Println(conv.Get(data, `labels.app`))
+
+	// Values may be converted in place using a Value.
+	v := conv.ValueOf(data)
+	fmt.Println(v.Get(`servers[1].port`).Int(), v.Err())
+	// Output:
+	// web <nil>
+	// [a b] <nil>
+	// <nil> path "labels.app" at ".app": key "app" not found in map[string]interface {}{"app.kubernetes.io/name":"web"} (type map[string]interface {})
+	// 8080 <nil>
+}
+
+// Guess converts untyped strings such as CSV fields or query parameters to the
+// most specific type they match.
+func ExampleGuess() {
+
+	for _, s := range []string{"42", "1.5", "true", "90s", "foo"} {
+		v, err := conv.Guess(s)
+		fmt.Printf("%T %v %v\n", v, v, err)
+	}
+
+	// GuessColumn chooses one type that every value within a column matches.
+	fmt.Println(conv.GuessColumn([]string{"1", "", "2.5"}))
+
+	// The types considered may be restricted by a Converter.
+	c := conv.Converter{GuessTypes: conv.GuessInt | conv.GuessString}
+	fmt.Println(c.GuessColumn([]string{"1", "1.5"}))
+	// Output:
+	// int64 42 <nil>
+	// float64 1.5 <nil>
+	// bool true <nil>
+	// time.Duration 1m30s <nil>
+	// string foo <nil>
+	// [1 <nil> 2.5] <nil>
+	// [1 1.5] <nil>
+}
+
+// Check and CanConvert report whether a conversion is possible without
+// assigning the result, such as when validating a schema or configuration.
+func ExampleCheck() {
+
+	// CanConvert reports whether any value of a type may be converted.
+	fmt.Println(conv.CanConvert(reflect.TypeOf(""), reflect.TypeOf(0)))
+	fmt.Println(conv.CanConvert(reflect.TypeOf(struct{}{}), reflect.TypeOf(0)))
+
+	// A Converter reports the conversions allowed by its options.
+	c := conv.Converter{Strict: true}
+	fmt.Println(c.CanConvert(reflect.TypeOf(true), reflect.TypeOf(0)))
+
+	// Check reports the error a conversion of a specific value would return.
+	fmt.Println(conv.Check(reflect.TypeOf(time.Duration(0)), "1m"))
+	fmt.Println(conv.Check(reflect.TypeOf(0), "abc"))
+	// Output:
+	// true
+	// false
+	// false
+	// <nil>
+	// cannot convert "abc" (type string) to int
+}
+
+// Explain describes each step of a conversion, which helps to understand a
+// surprising result such as a string parsed as a bool or a clamped number.
+func ExampleExplain() {
+
+	var into int8
+	steps, err := conv.Explain(&into, "300")
+	fmt.Println(into, err)
+	for _, step := range steps {
+		fmt.Println(step)
+	}
+
+	// A Converter may also report each step to its Trace func.
+	c := conv.Converter{Trace: func(step string) { fmt.Println(step) }}
+	fmt.Println(c.Int("T"))
+	// Output:
+	// 127 <nil>
+	// converting "300" (type string) to int8
+	// parsing "300" as a number
+	// clamped 300 to the range of int8
+	// parsing "T" as a number
+	// "T" is not a number, parsed it as the bool true
+	// 1 <nil>
+}
+
+// Exact reports conversions which lose information, such as rows of data which
+// would be silently rounded or clamped.
+func ExampleExact() {
+
+	var i int8
+	for _, from := range []interface{}{"12", "12.5", 300} {
+		lossy, err := conv.Exact(&i, from)
+		fmt.Println(i, lossy, err)
+	}
+
+	// Floats are compared by their exact value, strings by their decimal value.
+	var f float32
+	fmt.Println(conv.Exact(&f, 0.1))
+	fmt.Println(conv.Exact(&f, "0.1"))
+
+	// Durations converted from seconds are lossy if truncated.
+	var d time.Duration
+	fmt.Println(conv.Exact(&d, 1.5e-10))
+	// Output:
+	// 12 false <nil>
+	// 12 true <nil>
+	// 127 true <nil>
+	// true <nil>
+	// false <nil>
+	// true <nil>
+}
+
+// Converter allows changing how conversions are performed by setting its
+// fields, the zero value behaves identically to the package level functions.
+func ExampleConverter() {
+
+	// Numeric strings may be parsed using the conventions of a locale, digit
+	// grouping is validated strictly.
+	c := conv.Converter{Locale: conv.LocaleDE}
+	fmt.Println(c.Float64("-1.234.567,89"))
+	fmt.Println(c.Int("1,23,4"))
+
+	// Percent signs, currency symbols and accounting negatives may be allowed.
+	c = conv.Converter{
+		Locale: conv.LocaleEN,
+		Affixes: conv.Affixes{
+			Percent:  conv.PercentScale,
+			Currency: []string{"$", "USD"},
+			Parens:   true,
+		},
+	}
+	fmt.Println(c.Float64("45%"))
+	fmt.Println(c.Float64("-$1,200.50"))
+	fmt.Println(c.Int("(300)"))
+
+	// Magnitude suffixes are multiplied exactly, reporting overflow as errors.
+	c = conv.Converter{Suffixes: conv.SuffixBytes}
+	fmt.Println(c.Uint64("512MiB"))
+	fmt.Println(c.Uint64("10GB"))
+	fmt.Println(c.Uint64("16EiB"))
+
+	// Byte slices and arrays may be decoded as binary numbers.
+	c = conv.Converter{Binary: conv.BinaryBigEndian}
+	fmt.Println(c.Uint32([4]byte{0xde, 0xad, 0xbe, 0xef}))
+	fmt.Println(c.Bytes(int16(-2)))
+
+	// Collections holding a single element may be unwrapped rather than being
+	// converted to their length.
+	c = conv.Converter{Length: conv.LengthUnwrap}
+	fmt.Println(conv.Int([]string{"42"}))
+	fmt.Println(c.Int([]string{"42"}))
+
+	// Nil values, empty strings and literals such as "null" may be converted to
+	// zero values rather than returning an error.
+	c = conv.Converter{Empty: conv.EmptyZero}
+	fmt.Println(c.Int("null"))
+	fmt.Println(c.Bool((*string)(nil)))
+
+	// Strings may be cleaned up by a chain of preprocessors before parsing.
+	c = conv.Converter{Preprocess: []conv.Preprocessor{
+		conv.TrimSpace, conv.TrimQuotes}}
+	fmt.Println(c.Duration(` "5s" `))
+
+	// Strict conversions only accept the canonical syntax of each type.
+	c = conv.Converter{Strict: true}
+	fmt.Println(c.Int("42"))
+	fmt.Println(c.Int("yes"))
+	fmt.Println(c.Float64(true))
+	// Output:
+	// -1.23456789e+06 <nil>
+	// 0 cannot convert "1,23,4" (type string) to int
+	// 0.45 <nil>
+	// -1200.5 <nil>
+	// -300 <nil>
+	// 536870912 <nil>
+	// 10000000000 <nil>
+	// 0 cannot convert "16EiB" (type string) to uint64: value out of range
+	// 3735928559 <nil>
+	// [255 254] <nil>
+	// 1 <nil>
+	// 42 <nil>
+	// 0 <nil>
+	// false <nil>
+	// 5s <nil>
+	// 42 <nil>
+	// 0 cannot convert "yes" (type string) to int: invalid syntax
+	// 0 cannot convert true (type bool) to float64: conversion is not supported in strict mode
+}
+
 // Numeric conversion from other numeric values of an identical type will be
 // returned without modification. Numeric conversions deviate slightly from Go
 // when dealing with under/over flow. When performing a conversion operation
@@ -267,7 +597,8 @@ func Example_numerics() {
 
 	// For more natural Float -> Integer when the underlying value is a string.
 	// Conversion functions will always try to parse the value as the target type
-	// first. If parsing fails float parsing with truncation will be attempted.
+	// first. Decimal and exponent forms are parsed exactly then truncated, values
+	// beyond the range of the target type return an error.
 	fmt.Println(conv.Int("-123.456")) // -123
 
 	// This does not apply for unsigned integers if the value is negative. Instead
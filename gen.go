@@ -0,0 +1,196 @@
+//go:build ignore
+
+// Command gen writes the Or and Must variants and the Value methods of each
+// conversion declared by the convert.Converter interface, it is run by go
+// generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const header = "// Code generated by go run gen.go. DO NOT EDIT.\n\n"
+
+var importPaths = map[string]string{
+	"big":     "math/big",
+	"decimal": "github.com/cstockton/go-conv/decimal",
+	"time":    "time",
+}
+
+type conversion struct {
+	name string
+	typ  string
+	pkgs []string
+}
+
+func main() {
+	convs, err := parseConverter("internal/convert/convert.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := write("or.go", "conv", convs, writeConv); err != nil {
+		log.Fatal(err)
+	}
+	if err := write("internal/refconv/or.go", "refconv", convs, writeRefconv); err != nil {
+		log.Fatal(err)
+	}
+	if err := write("internal/refconv/valueconv.go", "refconv", convs, writeValue); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseConverter returns each conversion declared by the Converter interface
+// within the file at path, Infer is skipped as it has no result value.
+func parseConverter(path string) ([]conversion, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := f.Scope.Lookup("Converter")
+	if obj == nil {
+		return nil, fmt.Errorf("%v: missing Converter interface", path)
+	}
+	iface, ok := obj.Decl.(*ast.TypeSpec).Type.(*ast.InterfaceType)
+	if !ok {
+		return nil, fmt.Errorf("%v: Converter is not an interface", path)
+	}
+
+	var convs []conversion
+	for _, m := range iface.Methods.List {
+		fn, ok := m.Type.(*ast.FuncType)
+		if !ok || len(m.Names) != 1 || fn.Results == nil {
+			continue
+		}
+		if len(fn.Results.List) != 2 {
+			continue
+		}
+
+		res := fn.Results.List[0].Type
+		var buf bytes.Buffer
+		if err := printer.Fprint(&buf, fset, res); err != nil {
+			return nil, err
+		}
+
+		conv := conversion{name: m.Names[0].Name, typ: buf.String()}
+		ast.Inspect(res, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if id, ok := sel.X.(*ast.Ident); ok {
+					conv.pkgs = append(conv.pkgs, id.Name)
+				}
+			}
+			return true
+		})
+		convs = append(convs, conv)
+	}
+	return convs, nil
+}
+
+func write(path, pkg string, convs []conversion, fn func(*bytes.Buffer, conversion)) error {
+	seen := make(map[string]bool)
+	var imports []string
+	for _, conv := range convs {
+		for _, p := range conv.pkgs {
+			if !seen[p] {
+				seen[p] = true
+				imports = append(imports, importPaths[p])
+			}
+		}
+	}
+	sort.Slice(imports, func(i, j int) bool {
+		a, b := strings.Contains(imports[i], "."), strings.Contains(imports[j], ".")
+		if a != b {
+			return b
+		}
+		return imports[i] < imports[j]
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	fmt.Fprintf(&buf, "package %v\n\nimport (\n", pkg)
+	for i, imp := range imports {
+		if i > 0 && strings.Contains(imp, ".") &&
+			!strings.Contains(imports[i-1], ".") {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "\t%q\n", imp)
+	}
+	buf.WriteString(")\n")
+	for _, conv := range convs {
+		fn(&buf, conv)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("%v: %v", path, err)
+	}
+	return os.WriteFile(path, src, 0644)
+}
+
+func writeConv(buf *bytes.Buffer, conv conversion) {
+	fmt.Fprintf(buf, `
+// %[1]vOr is like %[1]v but returns def when the conversion fails.
+func %[1]vOr(from interface{}, def %[2]v) %[2]v {
+	return converter.%[1]vOr(from, def)
+}
+
+// Must%[1]v is like %[1]v but panics when the conversion fails.
+func Must%[1]v(from interface{}) %[2]v {
+	return converter.Must%[1]v(from)
+}
+`, conv.name, conv.typ)
+}
+
+func writeRefconv(buf *bytes.Buffer, conv conversion) {
+	fmt.Fprintf(buf, `
+// %[1]vOr is like %[1]v but returns def on failure.
+func (c Conv) %[1]vOr(from interface{}, def %[2]v) %[2]v {
+	if v, err := c.%[1]v(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// Must%[1]v is like %[1]v but panics on failure.
+func (c Conv) Must%[1]v(from interface{}) %[2]v {
+	v, err := c.%[1]v(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+`, conv.name, conv.typ)
+}
+
+func writeValue(buf *bytes.Buffer, conv conversion) {
+	fmt.Fprintf(buf, `
+// %[1]v converts the value wrapped by v, recording the first error.
+func (v Value) %[1]v() (to %[2]v) {
+	if v.ok() {
+		var err error
+		to, err = v.c.%[1]v(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// %[1]vOr is like %[1]v but returns def without recording errors.
+func (v Value) %[1]vOr(def %[2]v) %[2]v {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.%[1]vOr(v.from, def)
+}
+`, conv.name, conv.typ)
+}
@@ -0,0 +1,103 @@
+//go:build go1.21
+
+package conv
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/cstockton/go-conv/decimal"
+)
+
+// To will convert the given value to T, returns the zero value of T if a
+// conversion can not be made. Each type supported by Infer may be given for T,
+// along with named types, pointers, slices and maps of them.
+//
+// Example:
+//
+//   port, err := conv.To[uint16](`8080`)
+//   // port -> 8080
+//   ids, err := conv.To[[]int64]([]string{`1`, `2`})
+//   // ids -> []int64{1, 2}
+func To[T any](from any) (T, error) {
+	var into T
+	var err error
+	switch p := any(&into).(type) {
+	case *bool:
+		*p, err = converter.Bool(from)
+	case *complex64:
+		*p, err = converter.Complex64(from)
+	case *complex128:
+		*p, err = converter.Complex128(from)
+	case *float32:
+		*p, err = converter.Float32(from)
+	case *float64:
+		*p, err = converter.Float64(from)
+	case *int:
+		*p, err = converter.Int(from)
+	case *int8:
+		*p, err = converter.Int8(from)
+	case *int16:
+		*p, err = converter.Int16(from)
+	case *int32:
+		*p, err = converter.Int32(from)
+	case *int64:
+		*p, err = converter.Int64(from)
+	case *uint:
+		*p, err = converter.Uint(from)
+	case *uint8:
+		*p, err = converter.Uint8(from)
+	case *uint16:
+		*p, err = converter.Uint16(from)
+	case *uint32:
+		*p, err = converter.Uint32(from)
+	case *uint64:
+		*p, err = converter.Uint64(from)
+	case *string:
+		*p, err = converter.String(from)
+	case *[]byte:
+		*p, err = converter.Bytes(from)
+	case *time.Duration:
+		*p, err = converter.Duration(from)
+	case *time.Time:
+		*p, err = converter.Time(from)
+	case *decimal.Decimal:
+		*p, err = converter.Decimal(from)
+	case **big.Int:
+		*p, err = converter.BigInt(from)
+	case **big.Float:
+		*p, err = converter.BigFloat(from)
+	case **big.Rat:
+		*p, err = converter.BigRat(from)
+	default:
+		into, err = toInfer[T](from)
+	}
+	return into, err
+}
+
+// toInfer is kept apart from To so that only types converted by Infer cause
+// the value of T to escape to the heap.
+func toInfer[T any](from any) (T, error) {
+	var into T
+	err := converter.Infer(&into, from)
+	return into, err
+}
+
+// MustTo is like To but panics if a conversion can not be made, it is intended
+// for initializing variables from values known to be valid.
+func MustTo[T any](from any) T {
+	v, err := To[T](from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ToOr is like To but returns def if a conversion can not be made.
+func ToOr[T any](from any, def T) T {
+	v, err := To[T](from)
+	if err != nil {
+		return def
+	}
+	return v
+}
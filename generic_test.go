@@ -0,0 +1,199 @@
+//go:build go1.21
+
+package conv
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cstockton/go-conv/decimal"
+)
+
+func TestTo(t *testing.T) {
+	type ID int64
+
+	t.Run("Types", func(t *testing.T) {
+		chk := func(got, exp interface{}, err error) {
+			t.Helper()
+			if err != nil {
+				t.Errorf("To exp %v; got err: %v", exp, err)
+			} else if !reflect.DeepEqual(got, exp) {
+				t.Errorf("To exp %#v; got %#v", exp, got)
+			}
+		}
+		b, err := To[bool]("yes")
+		chk(b, true, err)
+		i, err := To[int]("42")
+		chk(i, 42, err)
+		i8, err := To[int8]("300")
+		chk(i8, int8(127), err)
+		u16, err := To[uint16]("8080")
+		chk(u16, uint16(8080), err)
+		f32, err := To[float32]("1.5")
+		chk(f32, float32(1.5), err)
+		c128, err := To[complex128]("1+2i")
+		chk(c128, 1+2i, err)
+		s, err := To[string](42)
+		chk(s, "42", err)
+		bs, err := To[[]byte]("hi")
+		chk(bs, []byte("hi"), err)
+		d, err := To[time.Duration]("1m")
+		chk(d, time.Minute, err)
+		tm, err := To[time.Time]("2006-01-02T15:04:05Z")
+		chk(tm, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), err)
+		bi, err := To[*big.Int]("12")
+		chk(bi, big.NewInt(12), err)
+		dec, err := To[decimal.Decimal]("1.50")
+		chk(dec.String(), "1.5", err)
+		id, err := To[ID]("7")
+		chk(id, ID(7), err)
+		ip, err := To[*int]("3")
+		chk(*ip, 3, err)
+		ids, err := To[[]ID]([]interface{}{"1", 2})
+		chk(ids, []ID{1, 2}, err)
+		m, err := To[map[string]float64](map[string]string{"a": "1.5"})
+		chk(m, map[string]float64{"a": 1.5}, err)
+		v, err := To[interface{}]("1")
+		chk(v, "1", err)
+		str, err := To[fmt.Stringer](time.Second)
+		chk(str, time.Second, err)
+		vs, err := To[[]interface{}]([]string{"a", "b"})
+		chk(vs, []interface{}{"a", "b"}, err)
+	})
+	t.Run("Errors", func(t *testing.T) {
+		if got, err := To[int]("foo"); err == nil || got != 0 {
+			t.Errorf("To exp err; got %v", got)
+		}
+		if got, err := To[[]int]([]string{"foo"}); err == nil || got != nil {
+			t.Errorf("To exp err; got %v", got)
+		}
+		if got, err := To[struct{}]("foo"); err == nil {
+			t.Errorf("To exp err; got %v", got)
+		}
+		if got, err := To[fmt.Stringer](42); err == nil {
+			t.Errorf("To exp err; got %v", got)
+		}
+	})
+	t.Run("ToOr", func(t *testing.T) {
+		if got := ToOr("42", 8080); got != 42 {
+			t.Errorf("ToOr exp 42; got %v", got)
+		}
+		if got := ToOr("foo", 8080); got != 8080 {
+			t.Errorf("ToOr exp 8080; got %v", got)
+		}
+	})
+	t.Run("MustTo", func(t *testing.T) {
+		if got := MustTo[time.Duration]("5s"); got != 5*time.Second {
+			t.Errorf("MustTo exp 5s; got %v", got)
+		}
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("MustTo exp panic")
+			}
+		}()
+		MustTo[int]("foo")
+	})
+}
+
+func BenchmarkTo(b *testing.B) {
+	var escape int64
+	from := "123"
+
+	b.Run(`Int64`, func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			got, err := Int64(from)
+			if err != nil {
+				b.Fatal(err)
+			}
+			escape = got
+		}
+	})
+	b.Run(`To`, func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			got, err := To[int64](from)
+			if err != nil {
+				b.Fatal(err)
+			}
+			escape = got
+		}
+	})
+	b.Run(`Infer`, func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			var got int64
+			if err := Infer(&got, from); err != nil {
+				b.Fatal(err)
+			}
+			escape = got
+		}
+	})
+	if escape != 123 {
+		b.Fatal(`bad value`)
+	}
+}
+
+func BenchmarkNumber(b *testing.B) {
+	var escape int8
+	from := 123.456
+
+	b.Run(`Int8`, func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			got, err := Int8(from)
+			if err != nil {
+				b.Fatal(err)
+			}
+			escape = got
+		}
+	})
+	b.Run(`Number`, func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			got, err := Number[int8](from)
+			if err != nil {
+				b.Fatal(err)
+			}
+			escape = got
+		}
+	})
+	if escape != 123 {
+		b.Fatal(`bad value`)
+	}
+}
+
+func ExampleNumber() {
+
+	// Number converts between numeric types without reflection, clamping values
+	// to the range of the target type.
+	fmt.Println(Number[int8](300))
+	fmt.Println(Number[uint](-1.5))
+	fmt.Println(NumberWith[int](Converter{Rounding: RoundHalfEven}, 2.5))
+	// Output:
+	// 127 <nil>
+	// 0 <nil>
+	// 2 <nil>
+}
+
+func ExampleTo() {
+
+	// To returns the value as the type given in brackets.
+	fmt.Println(To[int]("42"))
+	fmt.Println(To[[]time.Duration]([]string{"1s", "1m"}))
+
+	// ToOr returns a default when a conversion can not be made.
+	fmt.Println(ToOr("foo", 8080))
+	// Output:
+	// 42 <nil>
+	// [1s 1m0s] <nil>
+	// 8080
+}
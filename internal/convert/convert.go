@@ -1,15 +1,48 @@
 // Package convert contains common conversion interfaces.
 package convert
 
-import "time"
+import (
+	"math/big"
+	"time"
+
+	"github.com/cstockton/go-conv/decimal"
+)
 
 // Converter supports conversion across Go types.
 type Converter interface {
 
+	// BigFloat returns the *big.Float representation from the given interface
+	// value. Returns nil and an error on failure.
+	BigFloat(from interface{}) (to *big.Float, err error)
+
+	// BigInt returns the *big.Int representation from the given interface
+	// value. Returns nil and an error on failure.
+	BigInt(from interface{}) (to *big.Int, err error)
+
+	// BigRat returns the *big.Rat representation from the given interface
+	// value. Returns nil and an error on failure.
+	BigRat(from interface{}) (to *big.Rat, err error)
+
 	// Bool returns the bool representation from the given interface value.
 	// Returns the default value of false and an error on failure.
 	Bool(from interface{}) (to bool, err error)
 
+	// Bytes returns the []byte representation from the given interface value.
+	// Returns nil and an error on failure.
+	Bytes(from interface{}) (to []byte, err error)
+
+	// Complex64 returns the complex64 representation from the given interface
+	// value. Returns the default value of 0 and an error on failure.
+	Complex64(from interface{}) (to complex64, err error)
+
+	// Complex128 returns the complex128 representation from the given interface
+	// value. Returns the default value of 0 and an error on failure.
+	Complex128(from interface{}) (to complex128, err error)
+
+	// Decimal returns the decimal.Decimal representation from the given
+	// interface value. Returns the zero value and an error on failure.
+	Decimal(from interface{}) (to decimal.Decimal, err error)
+
 	// Duration returns the time.Duration representation from the given
 	// interface{} value. Returns the default value of 0 and an error on failure.
 	Duration(from interface{}) (to time.Duration, err error)
@@ -47,7 +80,7 @@ type Converter interface {
 	Int64(from interface{}) (to int64, err error)
 
 	// String returns the string representation from the given interface
-	// value and can not fail. An error is provided only for API cohesion.
+	// value. Returns an empty string and an error on failure.
 	String(from interface{}) (to string, err error)
 
 	// Time returns the time.Time{} representation from the given interface
@@ -1,8 +1,11 @@
 package convert
 
 import (
+	"math/big"
 	"sync/atomic"
 	"time"
+
+	"github.com/cstockton/go-conv/decimal"
 )
 
 func WithError(err error, fn FnConv) FnConv {
@@ -22,11 +25,46 @@ func WithErrorAfter(err error, after int, fn FnConv) FnConv {
 
 type FnConv func(into, from interface{}) error
 
+func (fn FnConv) BigFloat(from interface{}) (out *big.Float, err error) {
+	err = fn(&out, from)
+	return
+}
+
+func (fn FnConv) BigInt(from interface{}) (out *big.Int, err error) {
+	err = fn(&out, from)
+	return
+}
+
+func (fn FnConv) BigRat(from interface{}) (out *big.Rat, err error) {
+	err = fn(&out, from)
+	return
+}
+
 func (fn FnConv) Bool(from interface{}) (out bool, err error) {
 	err = fn(&out, from)
 	return
 }
 
+func (fn FnConv) Bytes(from interface{}) (out []byte, err error) {
+	err = fn(&out, from)
+	return
+}
+
+func (fn FnConv) Complex64(from interface{}) (out complex64, err error) {
+	err = fn(&out, from)
+	return
+}
+
+func (fn FnConv) Complex128(from interface{}) (out complex128, err error) {
+	err = fn(&out, from)
+	return
+}
+
+func (fn FnConv) Decimal(from interface{}) (out decimal.Decimal, err error) {
+	err = fn(&out, from)
+	return
+}
+
 func (fn FnConv) Duration(from interface{}) (out time.Duration, err error) {
 	err = fn(&out, from)
 	return
@@ -0,0 +1,128 @@
+package refconv
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// PercentMode controls how a trailing percent sign is handled within numeric
+// strings.
+type PercentMode uint8
+
+// Percent modes, the zero value rejects percent signs.
+const (
+	PercentNone  PercentMode = iota // "45%" is rejected
+	PercentScale                    // "45%" is 0.45
+	PercentKeep                     // "45%" is 45
+)
+
+// Affixes describes the decorations that may surround numeric strings, such as
+// percent signs, currency symbols and accounting negatives. The zero value
+// accepts none of them.
+type Affixes struct {
+
+	// Percent controls whether a trailing percent sign is accepted and if so
+	// whether the number is divided by 100.
+	Percent PercentMode
+
+	// Currency contains the currency symbols and codes, such as "$" or "USD",
+	// which may appear before or after a number. Spaces between the currency
+	// and the number are ignored.
+	Currency []string
+
+	// Parens accepts accounting negatives such as "(300)" as -300.
+	Parens bool
+}
+
+// IsZero returns true if a is the zero value Affixes.
+func (a Affixes) IsZero() bool {
+	return a.Percent == PercentNone && len(a.Currency) == 0 && !a.Parens
+}
+
+// Strip removes the decorations from the given numeric string which is then
+// normalized by the given Locale. The result is in the canonical form accepted
+// by the strconv package, i.e. "-$1,200.50" becomes "-1200.50" for LocaleEN
+// when "$" is a currency, or "45%" becomes "45e-2" with PercentScale.
+func (a Affixes) Strip(l Locale, s string) (string, error) {
+	if l.IsZero() && !a.IsZero() {
+		l = Locale{Decimal: '.'}
+	}
+	return a.strip(l, s, l.Normalize)
+}
+
+// strip removes the decorations from s, the remaining number is given to core
+// for normalization.
+func (a Affixes) strip(
+	l Locale, s string, core func(string) (string, error)) (string, error) {
+	if a.IsZero() {
+		return core(s)
+	}
+
+	v, neg := strings.TrimSpace(s), false
+	if a.Parens && len(v) > 1 && v[0] == '(' && v[len(v)-1] == ')' {
+		v, neg = strings.TrimSpace(v[1:len(v)-1]), true
+	}
+	if r, n := utf8.DecodeRuneInString(v); !neg && (r == '+' || l.isMinus(r)) {
+		v, neg = strings.TrimSpace(v[n:]), l.isMinus(r)
+	}
+
+	var percent bool
+	if a.Percent != PercentNone && strings.HasSuffix(v, "%") {
+		v, percent = strings.TrimSpace(v[:len(v)-1]), true
+	}
+	if cur := a.currency(v); len(cur) > 0 {
+		if strings.HasPrefix(v, cur) {
+			v = strings.TrimSpace(v[len(cur):])
+		} else {
+			v = strings.TrimSpace(v[:len(v)-len(cur)])
+		}
+	}
+	if !percent && a.Percent != PercentNone && strings.HasSuffix(v, "%") {
+		v, percent = strings.TrimSpace(v[:len(v)-1]), true
+	}
+
+	norm, err := core(v)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse %#v (type string) as number", s)
+	}
+	if neg {
+		if strings.HasPrefix(norm, "-") {
+			return "", fmt.Errorf(
+				"cannot parse %#v (type string) as number: multiple signs", s)
+		}
+		norm = "-" + norm
+	}
+	if percent && a.Percent == PercentScale {
+		norm = shiftExp(norm, -2)
+	}
+	return norm, nil
+}
+
+// currency returns the longest currency that prefixes or suffixes v.
+func (a Affixes) currency(v string) (found string) {
+	for _, cur := range a.Currency {
+		if len(cur) > len(found) &&
+			(strings.HasPrefix(v, cur) || strings.HasSuffix(v, cur)) {
+			found = cur
+		}
+	}
+	return
+}
+
+// shiftExp adds n to the exponent of the canonical numeric string s.
+func shiftExp(s string, n int) string {
+	mant, exp := s, 0
+	if i := strings.IndexByte(s, 'e'); i >= 0 {
+		var err error
+		if exp, err = strconv.Atoi(s[i+1:]); err != nil {
+			return s // exponent is far beyond the range of any Go number
+		}
+		mant = s[:i]
+	}
+	if strings.ContainsAny(mant, "iInN") {
+		return s // infinity and NaN are unchanged by scaling
+	}
+	return mant + "e" + strconv.Itoa(exp+n)
+}
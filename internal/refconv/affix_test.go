@@ -0,0 +1,91 @@
+package refconv
+
+import "testing"
+
+func TestAffixes(t *testing.T) {
+	finance := Affixes{
+		Percent:  PercentScale,
+		Currency: []string{"$", "€", "US$", "USD"},
+		Parens:   true,
+	}
+
+	t.Run("Strip", func(t *testing.T) {
+		tests := []struct {
+			a    Affixes
+			l    Locale
+			from string
+			exp  string
+		}{
+			{Affixes{}, Locale{}, "$12", "$12"},
+			{finance, Locale{}, "45%", "45e-2"},
+			{finance, Locale{}, "4.5e1%", "4.5e-1"},
+			{finance, Locale{}, "-Inf%", "-Inf"},
+			{finance, Locale{}, "+12", "12"},
+			{finance, Locale{}, "(300)", "-300"},
+			{finance, Locale{}, "( 300 )", "-300"},
+			{finance, Locale{}, "(+300)", "-300"},
+			{finance, Locale{}, "$-12", "-12"},
+			{finance, Locale{}, "1e99999999999999999999%", "1e99999999999999999999"},
+			{finance, LocaleEN, "-$1,200.50", "-1200.50"},
+			{finance, LocaleEN, "US$1,200", "1200"},
+			{finance, LocaleEN, "USD 1,200", "1200"},
+			{finance, LocaleEN, "($1,200)", "-1200"},
+			{finance, LocaleDE, "1.200,50 €", "1200.50"},
+			{finance, LocaleDE, "−12,5 %", "-12.5e-2"},
+			{finance, LocaleEN, "$45%", "45e-2"},
+			{Affixes{Percent: PercentKeep}, Locale{}, "45%", "45"},
+			{Affixes{Percent: PercentKeep}, Locale{}, " 45 % ", "45"},
+		}
+		for _, test := range tests {
+			got, err := test.a.Strip(test.l, test.from)
+			if err != nil {
+				t.Errorf("Strip(%q) unexpected err: %v", test.from, err)
+			} else if got != test.exp {
+				t.Errorf("Strip(%q) exp %q; got %q", test.from, test.exp, got)
+			}
+		}
+	})
+	t.Run("StripErrors", func(t *testing.T) {
+		tests := []struct {
+			a    Affixes
+			from string
+		}{
+			{Affixes{Currency: []string{"$"}}, "45%"},
+			{Affixes{Percent: PercentScale}, "$45"},
+			{Affixes{Percent: PercentScale}, "(45)"},
+			{finance, "-(45)"},
+			{finance, "(-45)"},
+			{finance, "--45"},
+			{finance, "$"},
+			{finance, "%"},
+			{finance, "45%%"},
+			{finance, "$45$"},
+		}
+		for _, test := range tests {
+			if got, err := test.a.Strip(Locale{}, test.from); err == nil {
+				t.Errorf("Strip(%q) exp err; got %q", test.from, got)
+			}
+		}
+	})
+	t.Run("Conv", func(t *testing.T) {
+		c := Conv{Locale: LocaleEN, Affixes: finance}
+		if got, err := c.Float64("45%"); err != nil || got != 0.45 {
+			t.Errorf("Float64 exp 0.45; got %v (%v)", got, err)
+		}
+		if got, err := c.Float64("-$1,200.50"); err != nil || got != -1200.5 {
+			t.Errorf("Float64 exp -1200.5; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64("(300)"); err != nil || got != -300 {
+			t.Errorf("Int64 exp -300; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64("+12"); err != nil || got != 12 {
+			t.Errorf("Int64 exp 12; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64("USD 1,200"); err != nil || got != 1200 {
+			t.Errorf("Uint64 exp 1200; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64("1,23,4"); err == nil {
+			t.Errorf("Int64 exp err; got %v", got)
+		}
+	})
+}
@@ -0,0 +1,350 @@
+package refconv
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/cstockton/go-conv/decimal"
+	"github.com/cstockton/go-conv/internal/refutil"
+)
+
+var (
+	typeOfBigInt   = reflect.TypeOf(big.Int{})
+	typeOfBigFloat = reflect.TypeOf(big.Float{})
+	typeOfBigRat   = reflect.TypeOf(big.Rat{})
+)
+
+// derefBig returns the value the math/big pointer v points to so it may be
+// assigned by Infer.
+func derefBig(v interface{}, err error) (interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	value := reflect.ValueOf(v)
+	if value.IsNil() {
+		return reflect.Zero(value.Type().Elem()).Interface(), nil
+	}
+	return value.Elem().Interface(), nil
+}
+
+// convStrToRat parses the canonical numeric string s exactly, exponents
+// beyond maxScaleExp are rejected to bound the size of the result.
+func convStrToRat(s string) (*big.Rat, error) {
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(s[i+1:])
+		if err != nil || exp > maxScaleExp || exp < -maxScaleExp {
+			return nil, fmt.Errorf("cannot parse %#v: exponent out of range", s)
+		}
+	}
+	if strings.ContainsAny(s, "/xXpP") {
+		return nil, fmt.Errorf("cannot parse %#v: not a decimal number", s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("cannot parse %#v: not a finite number", s)
+	}
+	return r, nil
+}
+
+// convBigToRat returns the exact value of the math/big number or decimal within
+// from. If from is an infinite big.Float the sign of the infinity is returned
+// instead, decimals with a scale beyond maxScaleExp return strconv.ErrRange
+// to bound the size of the result.
+func convBigToRat(from interface{}) (r *big.Rat, inf int, ok bool, err error) {
+	switch T := from.(type) {
+	case *big.Int:
+		if T != nil {
+			return new(big.Rat).SetInt(T), 0, true, nil
+		}
+	case *big.Rat:
+		if T != nil {
+			return new(big.Rat).Set(T), 0, true, nil
+		}
+	case *big.Float:
+		if T != nil {
+			if T.IsInf() {
+				return nil, T.Sign(), true, nil
+			}
+			r, _ = T.Rat(nil)
+			return r, 0, true, nil
+		}
+	case big.Int:
+		return convBigToRat(&T)
+	case big.Rat:
+		return convBigToRat(&T)
+	case big.Float:
+		return convBigToRat(&T)
+	case decimal.Decimal:
+		if T.Scale > maxScaleExp || T.Scale < -maxScaleExp {
+			return nil, 0, true, strconv.ErrRange
+		}
+		return T.Rat(), 0, true, nil
+	case *decimal.Decimal:
+		if T != nil {
+			return convBigToRat(*T)
+		}
+	}
+	return nil, 0, false, nil
+}
+
+// roundRat returns r rounded to an integer by the rounding mode of this Conv.
+func (c *Conv) roundRat(r *big.Rat) (*big.Int, error) {
+	if r.IsInt() {
+		return new(big.Int).Set(r.Num()), nil
+	}
+
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	neg := r.Sign() < 0
+	half := m.Abs(m).Lsh(m, 1).Cmp(r.Denom()) // compares |frac| to 1/2
+
+	var up bool
+	switch c.Rounding {
+	case RoundError:
+		return nil, errFraction
+	case RoundFloor:
+		up = neg
+	case RoundCeil:
+		up = !neg
+	case RoundHalfUp:
+		up = half >= 0
+	case RoundHalfEven:
+		up = half > 0 || (half == 0 && q.Bit(0) == 1)
+	}
+	if up {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	}
+	return q, nil
+}
+
+// isBig returns true if from is a math/big number or decimal, which are range
+// checked by every integer target rather than clamped to its range.
+func isBig(from interface{}) bool {
+	switch from.(type) {
+	case *big.Int, *big.Float, *big.Rat, big.Int, big.Float, big.Rat,
+		decimal.Decimal, *decimal.Decimal:
+		return true
+	}
+	return false
+}
+
+func (c *Conv) convRatToInt64(from interface{}, r *big.Rat, inf int) (int64, error) {
+	if inf != 0 {
+		return c.convFloatToInt64(from, math.Inf(inf))
+	}
+	i, err := c.roundRat(r)
+	if err != nil {
+		return 0, newConvErrReason(from, "int64", err)
+	}
+	if !i.IsInt64() {
+		return 0, newConvErrReason(from, "int64", strconv.ErrRange)
+	}
+	return i.Int64(), nil
+}
+
+func (c *Conv) convRatToUint64(from interface{}, r *big.Rat, inf int) (uint64, error) {
+	if inf != 0 {
+		return c.convFloatToUint64(from, math.Inf(inf))
+	}
+	i, err := c.roundRat(r)
+	if err != nil {
+		return 0, newConvErrReason(from, "uint64", err)
+	}
+	if !i.IsUint64() {
+		return 0, newConvErrReason(from, "uint64", strconv.ErrRange)
+	}
+	return i.Uint64(), nil
+}
+
+func (c *Conv) convRatToFloat64(from interface{}, r *big.Rat, inf int) (float64, error) {
+	if inf != 0 {
+		return c.convFloat64(from, math.Inf(inf))
+	}
+	f, _ := r.Float64()
+	if math.IsInf(f, 0) {
+		return 0, newConvErrReason(from, "float64", strconv.ErrRange)
+	}
+	return f, nil
+}
+
+// convBigRat returns the exact value of from for the numeric kinds and strings
+// shared by BigInt and BigRat, to names the target for errors.
+func (c *Conv) convBigRat(from interface{}, to string) (*big.Rat, error) {
+	if r, inf, ok, err := convBigToRat(from); err != nil {
+		return nil, newConvErrReason(from, to, err)
+	} else if ok {
+		if inf != 0 {
+			return c.convBigRatFloat(from, to, math.Inf(inf))
+		}
+		return r, nil
+	}
+
+	value := c.indirect(from)
+	kind := value.Kind()
+	switch {
+	case reflect.String == kind:
+		v, err := c.preprocess(value.String())
+		if err != nil {
+			return nil, newConvErrReason(from, to, err)
+		}
+		if norm, ok := c.convDecStr(v); ok {
+			if c.Strict && to == "*big.Int" && !isIntSyntax(norm, true) {
+				return nil, newConvErrReason(from, to, ErrSyntax)
+			}
+			if r, err := convStrToRat(norm); err == nil {
+				return r, nil
+			} else if !strings.ContainsAny(norm, "iInN") {
+				return nil, newConvErrReason(from, to, err)
+			}
+			if f, err := strconv.ParseFloat(norm, 64); err == nil {
+				return c.convBigRatFloat(from, to, f)
+			}
+		}
+		if c.Strict {
+			return nil, newConvErrReason(from, to, ErrSyntax)
+		}
+		if parsed, err := c.convStrToBool(v); err == nil {
+			if parsed {
+				return big.NewRat(1, 1), nil
+			}
+			return new(big.Rat), nil
+		}
+	case refutil.IsKindInt(kind):
+		return new(big.Rat).SetInt64(value.Int()), nil
+	case refutil.IsKindUint(kind):
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(value.Uint())), nil
+	case refutil.IsKindFloat(kind):
+		return c.convBigRatFloat(from, to, value.Float())
+	case refutil.IsKindComplex(kind):
+		f, err := c.convComplexToReal(value.Complex())
+		if err != nil {
+			return nil, newConvErrReason(from, to, err)
+		}
+		return c.convBigRatFloat(from, to, f)
+	case reflect.Bool == kind:
+		if c.Strict {
+			return nil, newConvErrReason(from, to, ErrUnsupported)
+		}
+		if value.Bool() {
+			return big.NewRat(1, 1), nil
+		}
+		return new(big.Rat), nil
+	case refutil.IsKindLength(kind):
+		if c.Length == LengthDefault && !c.Strict {
+			return big.NewRat(int64(value.Len()), 1), nil
+		}
+		elem, err := c.convUnwrap(value)
+		if err != nil {
+			return nil, newConvErrReason(from, to, err)
+		}
+		return c.convBigRat(elem, to)
+	}
+	return nil, c.newUnsupportedErr(from, to)
+}
+
+func (c *Conv) convBigRatFloat(from interface{}, to string, f float64) (*big.Rat, error) {
+	f, err := c.convNonFiniteBig(f, false)
+	if err != nil {
+		return nil, newConvErrReason(from, to, err)
+	}
+	return new(big.Rat).SetFloat64(f), nil
+}
+
+// BigInt attempts to convert the given value to a *big.Int, returns nil and an
+// error on failure. Fractional values are rounded by the rounding mode of this
+// Conv and numeric strings may be of any length.
+func (c Conv) BigInt(from interface{}) (*big.Int, error) {
+	if empty, err := c.convEmpty(from, "*big.Int"); empty {
+		return nil, err
+	}
+	if T, ok := from.(*big.Int); ok && T != nil {
+		return new(big.Int).Set(T), nil
+	}
+	r, err := c.convBigRat(from, "*big.Int")
+	if err != nil {
+		return nil, err
+	}
+	i, err := c.roundRat(r)
+	if err != nil {
+		return nil, newConvErrReason(from, "*big.Int", err)
+	}
+	return i, nil
+}
+
+// BigRat attempts to convert the given value to a *big.Rat, returns nil and an
+// error on failure. Numeric strings may be of any length and are parsed
+// exactly, as are floats.
+func (c Conv) BigRat(from interface{}) (*big.Rat, error) {
+	if empty, err := c.convEmpty(from, "*big.Rat"); empty {
+		return nil, err
+	}
+	return c.convBigRat(from, "*big.Rat")
+}
+
+// BigFloat attempts to convert the given value to a *big.Float, returns nil and
+// an error on failure. Numeric strings may be of any length and are parsed
+// with enough precision to hold each of their digits.
+func (c Conv) BigFloat(from interface{}) (*big.Float, error) {
+	if empty, err := c.convEmpty(from, "*big.Float"); empty {
+		return nil, err
+	}
+	switch T := from.(type) {
+	case *big.Float:
+		if T != nil {
+			return new(big.Float).Copy(T), nil
+		}
+	case big.Float:
+		return new(big.Float).Copy(&T), nil
+	}
+
+	value := c.indirect(from)
+	kind := value.Kind()
+	switch {
+	case reflect.String == kind:
+		v, err := c.preprocess(value.String())
+		if err != nil {
+			return nil, newConvErrReason(from, "*big.Float", err)
+		}
+		if norm, ok := c.convDecStr(v); ok {
+			if strings.ContainsAny(norm, "iInN") {
+				if f, err := strconv.ParseFloat(norm, 64); err == nil {
+					return c.convBigFloat(from, f)
+				}
+				break
+			}
+			if _, err := convStrToRat(norm); err != nil {
+				return nil, newConvErrReason(from, "*big.Float", err)
+			}
+			prec := uint(len(norm))*4 + 64
+			f, _, err := big.ParseFloat(norm, 10, prec, big.ToNearestEven)
+			if err == nil {
+				return f, nil
+			}
+		}
+	case refutil.IsKindFloat(kind):
+		return c.convBigFloat(from, value.Float())
+	case refutil.IsKindComplex(kind):
+		f, err := c.convComplexToReal(value.Complex())
+		if err != nil {
+			return nil, newConvErrReason(from, "*big.Float", err)
+		}
+		return c.convBigFloat(from, f)
+	}
+
+	r, err := c.convBigRat(from, "*big.Float")
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Float).SetRat(r), nil
+}
+
+func (c *Conv) convBigFloat(from interface{}, f float64) (*big.Float, error) {
+	f, err := c.convNonFiniteBig(f, true)
+	if err != nil {
+		return nil, newConvErrReason(from, "*big.Float", err)
+	}
+	return big.NewFloat(f), nil
+}
@@ -0,0 +1,264 @@
+package refconv
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cstockton/go-conv/decimal"
+)
+
+func TestBig(t *testing.T) {
+	huge := "1" + strings.Repeat("0", 40)
+	hugeInt, _ := new(big.Int).SetString(huge, 10)
+
+	t.Run("BigInt", func(t *testing.T) {
+		tests := []struct {
+			c    Conv
+			from interface{}
+			exp  string
+		}{
+			{Conv{}, huge, huge},
+			{Conv{}, "-" + huge + ".9", "-" + huge},
+			{Conv{}, "1.5e3", "1500"},
+			{Conv{}, "true", "1"},
+			{Conv{}, int8(-5), "-5"},
+			{Conv{}, uint64(math.MaxUint64), "18446744073709551615"},
+			{Conv{}, 2.9, "2"},
+			{Conv{}, float32(-2.5), "-2"},
+			{Conv{}, complex(7.5, 0), "7"},
+			{Conv{}, true, "1"},
+			{Conv{}, []int{1, 2}, "2"},
+			{Conv{}, hugeInt, huge},
+			{Conv{}, *hugeInt, huge},
+			{Conv{}, big.NewRat(7, 2), "3"},
+			{Conv{}, big.NewFloat(-7.5), "-7"},
+			{Conv{Rounding: RoundHalfEven}, big.NewRat(5, 2), "2"},
+			{Conv{Rounding: RoundHalfEven}, big.NewRat(7, 2), "4"},
+			{Conv{Rounding: RoundHalfEven}, big.NewRat(-7, 2), "-4"},
+			{Conv{Rounding: RoundHalfUp}, big.NewRat(-5, 2), "-3"},
+			{Conv{Rounding: RoundHalfUp}, big.NewRat(9, 4), "2"},
+			{Conv{Rounding: RoundFloor}, "-2.1", "-3"},
+			{Conv{Rounding: RoundCeil}, 2.1, "3"},
+			{Conv{Locale: LocaleDE}, "1.000.000.000.000.000.000.000", "1000000000000000000000"},
+			{Conv{Suffixes: SuffixSI}, "5Y", "5000000000000000000000000"},
+			{Conv{NonFinite: NonFiniteZero}, math.NaN(), "0"},
+		}
+		for _, test := range tests {
+			got, err := test.c.BigInt(test.from)
+			if err != nil {
+				t.Errorf("BigInt(%v) unexpected err: %v", test.from, err)
+			} else if got.String() != test.exp {
+				t.Errorf("BigInt(%v) exp %v; got %v", test.from, test.exp, got)
+			}
+		}
+	})
+	t.Run("BigRat", func(t *testing.T) {
+		tests := []struct {
+			c    Conv
+			from interface{}
+			exp  string
+		}{
+			{Conv{}, "0.1", "1/10"},
+			{Conv{}, huge + ".5", "2" + strings.Repeat("0", 39) + "1/2"},
+			{Conv{}, 0.5, "1/2"},
+			{Conv{}, int64(-3), "-3/1"},
+			{Conv{}, uint8(3), "3/1"},
+			{Conv{}, big.NewFloat(0.25), "1/4"},
+			{Conv{}, *big.NewRat(1, 3), "1/3"},
+			{Conv{}, false, "0/1"},
+			{Conv{}, "no", "0/1"},
+			{Conv{}, complex(0.5, 0), "1/2"},
+			{Conv{}, map[int]int{1: 1}, "1/1"},
+		}
+		for _, test := range tests {
+			got, err := test.c.BigRat(test.from)
+			if err != nil {
+				t.Errorf("BigRat(%v) unexpected err: %v", test.from, err)
+			} else if got.String() != test.exp {
+				t.Errorf("BigRat(%v) exp %v; got %v", test.from, test.exp, got)
+			}
+		}
+	})
+	t.Run("BigFloat", func(t *testing.T) {
+		tests := []struct {
+			c    Conv
+			from interface{}
+			exp  string
+		}{
+			{Conv{}, huge, huge},
+			{Conv{}, "0.5", "0.5"},
+			{Conv{}, 1.5, "1.5"},
+			{Conv{}, complex(1.5, 0), "1.5"},
+			{Conv{}, 12, "12"},
+			{Conv{}, hugeInt, huge},
+			{Conv{}, big.NewRat(1, 4), "0.25"},
+			{Conv{}, big.NewFloat(2.5), "2.5"},
+			{Conv{}, *big.NewFloat(2.5), "2.5"},
+			{Conv{}, "-Inf", "-Inf"},
+			{Conv{}, math.Inf(1), "+Inf"},
+			{Conv{NonFinite: NonFiniteZero}, "NaN", "0"},
+		}
+		for _, test := range tests {
+			got, err := test.c.BigFloat(test.from)
+			if err != nil {
+				t.Errorf("BigFloat(%v) unexpected err: %v", test.from, err)
+			} else if got.Text('f', -1) != test.exp {
+				t.Errorf("BigFloat(%v) exp %v; got %v",
+					test.from, test.exp, got.Text('f', -1))
+			}
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			c  Conv
+			fn func(c Conv, from interface{}) (interface{}, error)
+			v  interface{}
+		}{}
+		bigInt := func(c Conv, v interface{}) (interface{}, error) { return c.BigInt(v) }
+		bigRat := func(c Conv, v interface{}) (interface{}, error) { return c.BigRat(v) }
+		bigFloat := func(c Conv, v interface{}) (interface{}, error) { return c.BigFloat(v) }
+		for _, fn := range []func(Conv, interface{}) (interface{}, error){
+			bigInt, bigRat, bigFloat} {
+			tests = append(tests, []struct {
+				c  Conv
+				fn func(c Conv, from interface{}) (interface{}, error)
+				v  interface{}
+			}{
+				{Conv{}, fn, "foo"},
+				{Conv{}, fn, "1e99999"},
+				{Conv{}, fn, "NaN"},
+				{Conv{}, fn, math.NaN()},
+				{Conv{}, fn, struct{}{}},
+				{Conv{}, fn, nil},
+				{Conv{}, fn, (*big.Int)(nil)},
+				{Conv{StrictImaginary: true}, fn, 1 + 1i},
+				{Conv{NonFinite: NonFiniteError}, fn, math.Inf(1)},
+				{Conv{NonFinite: NonFiniteSaturate}, fn, math.Inf(1)},
+				{Conv{NonFinite: NonFiniteSaturate}, fn, "-Inf"},
+			}...)
+		}
+		tests = append(tests, []struct {
+			c  Conv
+			fn func(c Conv, from interface{}) (interface{}, error)
+			v  interface{}
+		}{
+			{Conv{}, bigInt, math.Inf(1)},
+			{Conv{}, bigRat, "Inf"},
+			{Conv{Rounding: RoundError}, bigInt, "1.5"},
+			{Conv{Rounding: RoundError}, bigInt, big.NewRat(1, 2)},
+		}...)
+		for _, test := range tests {
+			if got, err := test.fn(test.c, test.v); err == nil {
+				t.Errorf("exp err for %#v; got %v", test.v, got)
+			}
+		}
+	})
+	t.Run("Sources", func(t *testing.T) {
+		var c Conv
+		if got, err := c.Int64(big.NewInt(42)); err != nil || got != 42 {
+			t.Errorf("Int64 exp 42; got %v (%v)", got, err)
+		}
+		if got, err := c.Int8(big.NewInt(-128)); err != nil || got != math.MinInt8 {
+			t.Errorf("Int8 exp MinInt8; got %v (%v)", got, err)
+		}
+		if got, err := c.Int8(big.NewInt(-420)); !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("Int8 exp range err; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint8(big.NewInt(300)); !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("Uint8 exp range err; got %v (%v)", got, err)
+		}
+		if got, err := c.Int32(decimal.Decimal{Coef: 1 << 40}); !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("Int32 exp range err; got %v (%v)", got, err)
+		}
+		if got, err := c.Int8(300); err != nil || got != math.MaxInt8 {
+			t.Errorf("Int8 exp MaxInt8; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64(hugeInt); err == nil {
+			t.Errorf("Int64 exp range err; got %v", got)
+		}
+		if got, err := c.Int64(*big.NewRat(7, 2)); err != nil || got != 3 {
+			t.Errorf("Int64 exp 3; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64(new(big.Float).SetInf(true)); err != nil || got != math.MinInt64 {
+			t.Errorf("Int64 exp MinInt64; got %v (%v)", got, err)
+		}
+		if got, err := (Conv{Rounding: RoundError}).Int64(big.NewRat(7, 2)); err == nil {
+			t.Errorf("Int64 exp fraction err; got %v", got)
+		}
+		if got, err := c.Uint64(hugeInt); err == nil {
+			t.Errorf("Uint64 exp range err; got %v", got)
+		}
+		if got, err := c.Uint64(new(big.Int).Neg(hugeInt)); !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("Uint64 exp range err; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint8(big.NewInt(-1)); !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("Uint8 exp range err; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64(big.NewFloat(1e19)); err != nil || got != 1e19 {
+			t.Errorf("Uint64 exp 1e19; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64(new(big.Float).SetInf(false)); err != nil || got != math.MaxUint64 {
+			t.Errorf("Uint64 exp MaxUint64; got %v (%v)", got, err)
+		}
+		if got, err := (Conv{Rounding: RoundError}).Uint64(big.NewRat(7, 2)); err == nil {
+			t.Errorf("Uint64 exp fraction err; got %v", got)
+		}
+		if got, err := c.Float64(big.NewRat(1, 4)); err != nil || got != 0.25 {
+			t.Errorf("Float64 exp 0.25; got %v (%v)", got, err)
+		}
+		if got, err := c.Float32(hugeInt); !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("Float32 exp range err; got %v (%v)", got, err)
+		}
+		if got, err := c.Float32(big.NewFloat(1e300)); !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("Float32 exp range err; got %v (%v)", got, err)
+		}
+		if got, err := c.Float32(big.NewFloat(-1e30)); err != nil || got != -1e30 {
+			t.Errorf("Float32 exp -1e30; got %v (%v)", got, err)
+		}
+		if got, err := c.Float64(new(big.Int).Lsh(big.NewInt(1), 1100)); err == nil {
+			t.Errorf("Float64 exp range err; got %v", got)
+		}
+		if got, err := c.Float64(new(big.Float).SetInf(false)); err != nil || !math.IsInf(got, 1) {
+			t.Errorf("Float64 exp +Inf; got %v (%v)", got, err)
+		}
+	})
+	t.Run("Infer", func(t *testing.T) {
+		var c Conv
+		var (
+			ip *big.Int
+			iv big.Int
+			fp *big.Float
+			fv big.Float
+			rp *big.Rat
+			rv big.Rat
+		)
+		for _, into := range []interface{}{&ip, &iv, &fp, &fv, &rp, &rv} {
+			if err := c.Infer(into, "12"); err != nil {
+				t.Errorf("Infer(%T) unexpected err: %v", into, err)
+			}
+		}
+		if ip.Int64() != 12 || iv.Int64() != 12 {
+			t.Errorf("Infer exp 12; got %v and %v", ip, &iv)
+		}
+		if f, _ := fp.Float64(); f != 12 {
+			t.Errorf("Infer exp 12; got %v", fp)
+		}
+		if f, _ := fv.Float64(); f != 12 {
+			t.Errorf("Infer exp 12; got %v", &fv)
+		}
+		if rp.RatString() != "12" || rv.RatString() != "12" {
+			t.Errorf("Infer exp 12; got %v and %v", rp, &rv)
+		}
+		if err := c.Infer(&iv, "foo"); err == nil {
+			t.Error("Infer exp err")
+		}
+		var sp *string
+		if err := c.Infer(&sp, "foo"); err != nil || sp == nil || *sp != "foo" {
+			t.Errorf("Infer exp foo; got %v (%v)", sp, err)
+		}
+	})
+}
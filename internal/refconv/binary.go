@@ -0,0 +1,160 @@
+package refconv
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+
+	"github.com/cstockton/go-conv/internal/refutil"
+)
+
+// BinaryMode controls whether byte slices and arrays are decoded as binary
+// numbers by the integer and float conversions, and how numbers are encoded by
+// Bytes.
+type BinaryMode uint8
+
+// Binary modes, the zero value converts byte slices and arrays to their length
+// and gives numbers their string representation in Bytes.
+const (
+
+	// BinaryNone converts byte slices and arrays to their length.
+	BinaryNone BinaryMode = iota
+
+	// BinaryBigEndian decodes 1, 2, 4 or 8 bytes as a big endian integer of
+	// that width, or 4 and 8 bytes as an IEEE 754 float.
+	BinaryBigEndian
+
+	// BinaryLittleEndian is like BinaryBigEndian using little endian order.
+	BinaryLittleEndian
+
+	// BinaryVarint decodes the variable length integers of the encoding/binary
+	// package, signed integers are zig-zag encoded. Floats are not supported.
+	BinaryVarint
+)
+
+var (
+	errBinaryWidth  = errors.New("binary value must be 1, 2, 4 or 8 bytes")
+	errBinaryFloat  = errors.New("binary float must be 4 or 8 bytes")
+	errBinaryVarint = errors.New("invalid varint")
+	errVarintFloat  = errors.New("varint can not hold a float")
+	errBinaryKind   = errors.New("binary value must be an integer or float")
+)
+
+func (m BinaryMode) order() binary.ByteOrder {
+	if m == BinaryLittleEndian {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
+
+// convBytesToInt64 decodes b as a signed integer, values narrower than 8 bytes
+// are sign extended.
+func (c *Conv) convBytesToInt64(b []byte) (int64, error) {
+	if c.Binary == BinaryVarint {
+		v, n := binary.Varint(b)
+		if n <= 0 || n != len(b) {
+			return 0, errBinaryVarint
+		}
+		return v, nil
+	}
+
+	order := c.Binary.order()
+	switch len(b) {
+	case 1:
+		return int64(int8(b[0])), nil
+	case 2:
+		return int64(int16(order.Uint16(b))), nil
+	case 4:
+		return int64(int32(order.Uint32(b))), nil
+	case 8:
+		return int64(order.Uint64(b)), nil
+	}
+	return 0, errBinaryWidth
+}
+
+// convBytesToUint64 decodes b as an unsigned integer.
+func (c *Conv) convBytesToUint64(b []byte) (uint64, error) {
+	if c.Binary == BinaryVarint {
+		v, n := binary.Uvarint(b)
+		if n <= 0 || n != len(b) {
+			return 0, errBinaryVarint
+		}
+		return v, nil
+	}
+
+	order := c.Binary.order()
+	switch len(b) {
+	case 1:
+		return uint64(b[0]), nil
+	case 2:
+		return uint64(order.Uint16(b)), nil
+	case 4:
+		return uint64(order.Uint32(b)), nil
+	case 8:
+		return order.Uint64(b), nil
+	}
+	return 0, errBinaryWidth
+}
+
+// convBytesToFloat64 decodes b as an IEEE 754 float32 or float64.
+func (c *Conv) convBytesToFloat64(b []byte) (float64, error) {
+	if c.Binary == BinaryVarint {
+		return 0, errVarintFloat
+	}
+
+	order := c.Binary.order()
+	switch len(b) {
+	case 4:
+		return float64(math.Float32frombits(order.Uint32(b))), nil
+	case 8:
+		return math.Float64frombits(order.Uint64(b)), nil
+	}
+	return 0, errBinaryFloat
+}
+
+// convNumToBinary encodes the int, uint or float value using the width of its
+// type, or as a varint.
+func (c *Conv) convNumToBinary(value reflect.Value) ([]byte, error) {
+	kind := value.Kind()
+	if c.Binary == BinaryVarint {
+		b := make([]byte, binary.MaxVarintLen64)
+		switch {
+		case refutil.IsKindInt(kind):
+			return b[:binary.PutVarint(b, value.Int())], nil
+		case refutil.IsKindUint(kind):
+			return b[:binary.PutUvarint(b, value.Uint())], nil
+		case refutil.IsKindFloat(kind):
+			return nil, errVarintFloat
+		}
+		return nil, errBinaryKind
+	}
+
+	var bits uint64
+	switch {
+	case refutil.IsKindInt(kind):
+		bits = uint64(value.Int())
+	case refutil.IsKindUint(kind):
+		bits = value.Uint()
+	case reflect.Float32 == kind:
+		bits = uint64(math.Float32bits(float32(value.Float())))
+	case reflect.Float64 == kind:
+		bits = math.Float64bits(value.Float())
+	default:
+		return nil, errBinaryKind
+	}
+
+	order := c.Binary.order()
+	b := make([]byte, value.Type().Size())
+	switch len(b) {
+	case 1:
+		b[0] = byte(bits)
+	case 2:
+		order.PutUint16(b, uint16(bits))
+	case 4:
+		order.PutUint32(b, uint32(bits))
+	case 8:
+		order.PutUint64(b, bits)
+	}
+	return b, nil
+}
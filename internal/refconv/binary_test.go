@@ -0,0 +1,195 @@
+package refconv
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestBinary(t *testing.T) {
+	type ulyBytes []byte
+	be := Conv{Binary: BinaryBigEndian}
+	le := Conv{Binary: BinaryLittleEndian}
+	vi := Conv{Binary: BinaryVarint}
+
+	t.Run("Int64", func(t *testing.T) {
+		tests := []struct {
+			c    Conv
+			from interface{}
+			exp  int64
+		}{
+			{Conv{}, []byte{1, 0}, 2},
+			{be, []byte{0x7f}, 127},
+			{be, []byte{0xff}, -1},
+			{be, []byte{1, 0}, 256},
+			{le, []byte{1, 0}, 1},
+			{be, [2]byte{0xff, 0xfe}, -2},
+			{be, &[2]byte{0xff, 0xfe}, -2},
+			{be, ulyBytes{0, 0, 1, 0}, 256},
+			{be, [4]byte{0x80, 0, 0, 0}, math.MinInt32},
+			{le, [4]byte{0, 0, 0, 0x80}, math.MinInt32},
+			{be, [8]byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, math.MaxInt64},
+			{le, [8]byte{1}, 1},
+			{vi, []byte{0x01}, -1},
+			{vi, []byte{0x02}, 1},
+			{vi, []byte{0xd8, 0x04}, 300},
+			{be, "12", 12},
+			{be, []string{"a"}, 1},
+		}
+		for _, test := range tests {
+			got, err := test.c.Int64(test.from)
+			if err != nil {
+				t.Errorf("Int64(%v) unexpected err: %v", test.from, err)
+			} else if got != test.exp {
+				t.Errorf("Int64(%v) exp %v; got %v", test.from, test.exp, got)
+			}
+		}
+	})
+	t.Run("Uint64", func(t *testing.T) {
+		tests := []struct {
+			c    Conv
+			from interface{}
+			exp  uint64
+		}{
+			{be, []byte{0xff}, 255},
+			{be, []byte{1, 0}, 256},
+			{le, []byte{1, 0}, 1},
+			{be, [4]byte{0xff, 0xff, 0xff, 0xff}, math.MaxUint32},
+			{le, [4]byte{0x78, 0x56, 0x34, 0x12}, 0x12345678},
+			{be, [8]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, math.MaxUint64},
+			{vi, []byte{0xac, 0x02}, 300},
+		}
+		for _, test := range tests {
+			got, err := test.c.Uint64(test.from)
+			if err != nil {
+				t.Errorf("Uint64(%v) unexpected err: %v", test.from, err)
+			} else if got != test.exp {
+				t.Errorf("Uint64(%v) exp %v; got %v", test.from, test.exp, got)
+			}
+		}
+		if got, err := be.Uint32([4]byte{0xde, 0xad, 0xbe, 0xef}); err != nil || got != 0xdeadbeef {
+			t.Errorf("Uint32 exp 0xdeadbeef; got %#x (%v)", got, err)
+		}
+		if got, err := be.Int32([4]byte{0xff, 0xff, 0xff, 0xfe}); err != nil || got != -2 {
+			t.Errorf("Int32 exp -2; got %v (%v)", got, err)
+		}
+	})
+	t.Run("Float64", func(t *testing.T) {
+		tests := []struct {
+			c    Conv
+			from interface{}
+			exp  float64
+		}{
+			{be, [4]byte{0x3f, 0xc0, 0, 0}, 1.5},
+			{le, [4]byte{0, 0, 0xc0, 0x3f}, 1.5},
+			{be, [8]byte{0x3f, 0xf8}, 1.5},
+			{le, [8]byte{6: 0xf8, 7: 0x3f}, 1.5},
+			{be, []byte{0xc0, 0x45, 0, 0, 0, 0, 0, 0}, -42},
+		}
+		for _, test := range tests {
+			got, err := test.c.Float64(test.from)
+			if err != nil {
+				t.Errorf("Float64(%v) unexpected err: %v", test.from, err)
+			} else if got != test.exp {
+				t.Errorf("Float64(%v) exp %v; got %v", test.from, test.exp, got)
+			}
+		}
+		if got, err := be.Float32([4]byte{0x3f, 0xc0, 0, 0}); err != nil || got != 1.5 {
+			t.Errorf("Float32 exp 1.5; got %v (%v)", got, err)
+		}
+		c := Conv{Binary: BinaryBigEndian, NonFinite: NonFiniteError}
+		if got, err := c.Float64([4]byte{0x7f, 0xc0, 0, 0}); err == nil {
+			t.Errorf("Float64 exp NaN err; got %v", got)
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			c    Conv
+			from interface{}
+		}{
+			{be, []byte{}},
+			{be, []byte{1, 2, 3}},
+			{le, [16]byte{}},
+			{vi, []byte{}},
+			{vi, []byte{0x80}},
+			{vi, []byte{0x02, 0x02}},
+			{vi, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+		}
+		for _, test := range tests {
+			if got, err := test.c.Int64(test.from); err == nil {
+				t.Errorf("Int64(%v) exp err; got %v", test.from, got)
+			}
+			if got, err := test.c.Uint64(test.from); err == nil {
+				t.Errorf("Uint64(%v) exp err; got %v", test.from, got)
+			}
+			if got, err := test.c.Float64(test.from); err == nil {
+				t.Errorf("Float64(%v) exp err; got %v", test.from, got)
+			}
+		}
+		for _, from := range []interface{}{[]byte{1}, [2]byte{}} {
+			if got, err := be.Float64(from); err == nil {
+				t.Errorf("Float64(%v) exp err; got %v", from, got)
+			}
+		}
+		if got, err := vi.Float64([]byte{0x02}); err == nil {
+			t.Errorf("Float64 exp varint err; got %v", got)
+		}
+	})
+	t.Run("Bytes", func(t *testing.T) {
+		tests := []struct {
+			c    Conv
+			from interface{}
+			exp  []byte
+		}{
+			{Conv{}, 256, []byte("256")},
+			{be, int8(-1), []byte{0xff}},
+			{be, uint8(7), []byte{7}},
+			{be, int16(256), []byte{1, 0}},
+			{le, int16(256), []byte{0, 1}},
+			{be, int32(math.MinInt32), []byte{0x80, 0, 0, 0}},
+			{be, uint32(0xdeadbeef), []byte{0xde, 0xad, 0xbe, 0xef}},
+			{le, uint32(0xdeadbeef), []byte{0xef, 0xbe, 0xad, 0xde}},
+			{be, int64(1), []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+			{le, uint64(1), []byte{1, 0, 0, 0, 0, 0, 0, 0}},
+			{be, float32(1.5), []byte{0x3f, 0xc0, 0, 0}},
+			{le, 1.5, []byte{0, 0, 0, 0, 0, 0, 0xf8, 0x3f}},
+			{vi, -1, []byte{0x01}},
+			{vi, 300, []byte{0xd8, 0x04}},
+			{vi, uint(300), []byte{0xac, 0x02}},
+			{be, "hi", []byte("hi")},
+		}
+		for _, test := range tests {
+			got, err := test.c.Bytes(test.from)
+			if err != nil {
+				t.Errorf("Bytes(%v) unexpected err: %v", test.from, err)
+			} else if !bytes.Equal(got, test.exp) {
+				t.Errorf("Bytes(%v) exp %v; got %v", test.from, test.exp, got)
+			}
+		}
+		for _, test := range []struct {
+			c    Conv
+			from interface{}
+		}{
+			{vi, 1.5},
+			{vi, 1 + 2i},
+			{be, 1 + 2i},
+		} {
+			if got, err := test.c.Bytes(test.from); err == nil {
+				t.Errorf("Bytes(%v) exp err; got %v", test.from, got)
+			}
+		}
+	})
+	t.Run("RoundTrip", func(t *testing.T) {
+		for _, c := range []Conv{be, le, vi} {
+			for _, v := range []int64{0, 1, -1, math.MaxInt64, math.MinInt64} {
+				b, err := c.Bytes(v)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got, err := c.Int64(b); err != nil || got != v {
+					t.Errorf("Int64(%v) exp %v; got %v (%v)", b, v, got, err)
+				}
+			}
+		}
+	})
+}
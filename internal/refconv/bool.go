@@ -3,6 +3,7 @@ package refconv
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"time"
 
 	"github.com/cstockton/go-conv/internal/refutil"
@@ -15,19 +16,25 @@ type boolConverter interface {
 // Bool attempts to convert the given value to bool, returns the zero value
 // and an error on failure.
 func (c Conv) Bool(from interface{}) (bool, error) {
+	if empty, err := c.convEmpty(from, "bool"); empty {
+		return false, err
+	}
 	if T, ok := from.(string); ok {
 		return c.convStrToBool(T)
 	} else if T, ok := from.(bool); ok {
 		return T, nil
-	} else if c, ok := from.(boolConverter); ok {
-		return c.Bool()
+	} else if T, ok := from.(boolConverter); ok {
+		c.traceHook(from, "Bool")
+		return T.Bool()
 	}
 
-	value := refutil.IndirectVal(reflect.ValueOf(from))
+	value := c.indirect(from)
 	kind := value.Kind()
 	switch {
 	case reflect.String == kind:
 		return c.convStrToBool(value.String())
+	case refutil.IsKindNumeric(kind) && c.Strict:
+		return false, newConvErrReason(from, "bool", ErrUnsupported)
 	case refutil.IsKindNumeric(kind):
 		if parsed, ok := c.convNumToBool(kind, value); ok {
 			return parsed, nil
@@ -35,21 +42,37 @@ func (c Conv) Bool(from interface{}) (bool, error) {
 	case reflect.Bool == kind:
 		return value.Bool(), nil
 	case refutil.IsKindLength(kind):
-		return value.Len() > 0, nil
-	case reflect.Struct == kind && value.CanInterface():
+		if c.Length == LengthDefault && !c.Strict {
+			c.tracef("converted %v to whether its length %d is non-zero",
+				value.Type(), value.Len())
+			return value.Len() > 0, nil
+		}
+		elem, err := c.convUnwrap(value)
+		if err != nil {
+			return false, newConvErrReason(from, "bool", err)
+		}
+		return c.Bool(elem)
+	case reflect.Struct == kind && value.CanInterface() && !c.Strict:
 		v := value.Interface()
 		if t, ok := v.(time.Time); ok {
+			c.tracef("converted %v to whether it is non-zero", t)
 			return emptyTime != t, nil
 		}
 	}
-	return false, newConvErr(from, "bool")
+	return false, c.newUnsupportedErr(from, "bool")
 }
 
-func (c Conv) convStrToBool(v string) (bool, error) {
-	// @TODO Need to find a clean way to expose the truth list to be modified by
-	// API to allow INTL.
-	if 1 > len(v) || len(v) > 5 {
-		return false, fmt.Errorf("cannot parse string with len %d as bool", len(v))
+func (c *Conv) convStrToBool(v string) (bool, error) {
+	v, err := c.preprocess(v)
+	if err != nil {
+		return false, newConvErrReason(v, "bool", err)
+	}
+	if c.Strict {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, newConvErrReason(v, "bool", ErrSyntax)
+		}
+		return parsed, nil
 	}
 
 	// @TODO lut
@@ -59,5 +82,16 @@ func (c Conv) convStrToBool(v string) (bool, error) {
 	case "0", "f", "F", "false", "False", "FALSE", "n", "N", "no", "No", "NO":
 		return false, nil
 	}
+	if f, ok := parseNonFinite(v); ok {
+		if parsed, ok := c.convFloatToBool(f); ok {
+			return parsed, nil
+		}
+		return false, newConvErrReason(v, "bool", errNotFinite)
+	}
+	// @TODO Need to find a clean way to expose the truth list to be modified by
+	// API to allow INTL.
+	if 1 > len(v) || len(v) > 5 {
+		return false, fmt.Errorf("cannot parse string with len %d as bool", len(v))
+	}
 	return false, fmt.Errorf("cannot parse %#v (type string) as bool", v)
 }
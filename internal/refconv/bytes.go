@@ -0,0 +1,179 @@
+package refconv
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/cstockton/go-conv/internal/refutil"
+)
+
+var typeOfBytes = reflect.TypeOf([]byte(nil))
+
+// Encoding selects how strings are decoded into bytes by Bytes, and how bytes
+// are encoded into strings by String.
+type Encoding uint8
+
+// Encodings, the zero value casts between strings and bytes.
+const (
+
+	// EncodingNone casts strings to bytes and bytes to strings as is.
+	EncodingNone Encoding = iota
+
+	// EncodingHex uses hexadecimal, decoding accepts either case.
+	EncodingHex
+
+	// EncodingBase64 uses the padded standard base64 alphabet.
+	EncodingBase64
+
+	// EncodingBase64URL uses the padded URL and file name safe base64 alphabet.
+	EncodingBase64URL
+
+	// EncodingBase64Raw uses the standard base64 alphabet without padding.
+	EncodingBase64Raw
+
+	// EncodingBase64RawURL uses the URL and file name safe base64 alphabet
+	// without padding.
+	EncodingBase64RawURL
+
+	// EncodingBase32 uses the padded standard base32 alphabet.
+	EncodingBase32
+)
+
+// Decode returns the bytes represented by s in this encoding.
+func (e Encoding) Decode(s string) ([]byte, error) {
+	switch e {
+	case EncodingNone:
+		return []byte(s), nil
+	case EncodingHex:
+		return hex.DecodeString(s)
+	case EncodingBase64:
+		return base64.StdEncoding.DecodeString(s)
+	case EncodingBase64URL:
+		return base64.URLEncoding.DecodeString(s)
+	case EncodingBase64Raw:
+		return base64.RawStdEncoding.DecodeString(s)
+	case EncodingBase64RawURL:
+		return base64.RawURLEncoding.DecodeString(s)
+	case EncodingBase32:
+		return base32.StdEncoding.DecodeString(s)
+	}
+	return nil, fmt.Errorf("unknown encoding %d", e)
+}
+
+// Encode returns the string representation of b in this encoding.
+func (e Encoding) Encode(b []byte) string {
+	switch e {
+	case EncodingHex:
+		return hex.EncodeToString(b)
+	case EncodingBase64:
+		return base64.StdEncoding.EncodeToString(b)
+	case EncodingBase64URL:
+		return base64.URLEncoding.EncodeToString(b)
+	case EncodingBase64Raw:
+		return base64.RawStdEncoding.EncodeToString(b)
+	case EncodingBase64RawURL:
+		return base64.RawURLEncoding.EncodeToString(b)
+	case EncodingBase32:
+		return base32.StdEncoding.EncodeToString(b)
+	}
+	return string(b)
+}
+
+type bytesConverter interface {
+	Bytes() ([]byte, error)
+}
+
+// Bytes attempts to convert the given value to []byte, returns nil and an error
+// on failure. Strings are decoded using the Encoding of this Conv, runes are
+// encoded as UTF-8, an io.Reader is read until EOF and numbers are given their
+// string representation unless the Binary mode of this Conv is set. The
+// returned slice never shares memory with from.
+func (c Conv) Bytes(from interface{}) ([]byte, error) {
+	if empty, err := c.convEmpty(from, "[]byte"); empty {
+		return nil, err
+	}
+	switch T := from.(type) {
+	case []byte:
+		return append([]byte{}, T...), nil
+	case *[]byte:
+		if T != nil {
+			return append([]byte{}, *T...), nil
+		}
+	case string:
+		return c.convStrToBytes(from, T)
+	case *string:
+		if T != nil {
+			return c.convStrToBytes(from, *T)
+		}
+	case []rune:
+		return []byte(string(T)), nil
+	case bytesConverter:
+		c.traceHook(from, "Bytes")
+		return T.Bytes()
+	case io.Reader:
+		c.traceHook(from, "Read")
+		b, err := io.ReadAll(T)
+		if err != nil {
+			return nil, newConvErrReason(from, "[]byte", err)
+		}
+		return b, nil
+	case stringConverter:
+		c.traceHook(from, "String")
+		s, err := T.String()
+		if err != nil {
+			return nil, err
+		}
+		return c.convStrToBytes(from, s)
+	}
+
+	value := c.indirect(from)
+	kind := value.Kind()
+	switch {
+	case reflect.String == kind:
+		return c.convStrToBytes(from, value.String())
+	case isKindBytes(value):
+		return c.convValToBytes(value), nil
+	case c.Binary != BinaryNone && refutil.IsKindNumeric(kind):
+		b, err := c.convNumToBinary(value)
+		if err != nil {
+			return nil, newConvErrReason(from, "[]byte", err)
+		}
+		return b, nil
+	case refutil.IsKindNumeric(kind):
+		s, err := c.String(value.Interface())
+		if err != nil {
+			return nil, err
+		}
+		return []byte(s), nil
+	}
+	return nil, c.newUnsupportedErr(from, "[]byte")
+}
+
+func (c *Conv) convStrToBytes(from interface{}, s string) ([]byte, error) {
+	s, err := c.preprocess(s)
+	if err != nil {
+		return nil, newConvErrReason(from, "[]byte", err)
+	}
+	b, err := c.Encoding.Decode(s)
+	if err != nil {
+		return nil, newConvErrReason(from, "[]byte", err)
+	}
+	return b, nil
+}
+
+// isKindBytes returns true if value is a slice or array of bytes.
+func isKindBytes(value reflect.Value) bool {
+	k := value.Kind()
+	return (reflect.Array == k || reflect.Slice == k) &&
+		value.Type().Elem().Kind() == reflect.Uint8
+}
+
+func (c *Conv) convValToBytes(value reflect.Value) []byte {
+	b := make([]byte, value.Len())
+	reflect.Copy(reflect.ValueOf(b), value)
+	return b
+}
@@ -0,0 +1,153 @@
+package refconv
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type testBytesConverter string
+
+func (t testBytesConverter) Bytes() ([]byte, error) {
+	return []byte("bytes:" + t), nil
+}
+
+type errReader struct{}
+
+func (errReader) Read(p []byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestBytes(t *testing.T) {
+	type ulyBytes []byte
+	type ulyString string
+	hi := []byte("hi?")
+
+	t.Run("Bytes", func(t *testing.T) {
+		str, strs := "hi?", []byte("hi?")
+		tests := []struct {
+			enc  Encoding
+			from interface{}
+			exp  []byte
+		}{
+			{EncodingNone, "hi?", hi},
+			{EncodingNone, &str, hi},
+			{EncodingNone, ulyString("hi?"), hi},
+			{EncodingNone, hi, hi},
+			{EncodingNone, &strs, hi},
+			{EncodingNone, ulyBytes("hi?"), hi},
+			{EncodingNone, [3]byte{'h', 'i', '?'}, hi},
+			{EncodingNone, &[3]byte{'h', 'i', '?'}, hi},
+			{EncodingNone, []rune("hi?"), hi},
+			{EncodingNone, []rune("日本"), []byte("日本")},
+			{EncodingNone, strings.NewReader("hi?"), hi},
+			{EncodingNone, bytes.NewBufferString("hi?"), hi},
+			{EncodingNone, testBytesConverter("hi"), []byte("bytes:hi")},
+			{EncodingNone, 12, []byte("12")},
+			{EncodingNone, uint8(12), []byte("12")},
+			{EncodingNone, -1.5, []byte("-1.5")},
+			{EncodingNone, 1 + 2i, []byte("(1+2i)")},
+			{EncodingNone, "", []byte{}},
+			{EncodingHex, "68693f", hi},
+			{EncodingHex, "68693F", hi},
+			{EncodingHex, hi, hi},
+			{EncodingBase64, "aGk/", hi},
+			{EncodingBase64URL, "aGk_", hi},
+			{EncodingBase64Raw, "aGk", []byte("hi")},
+			{EncodingBase64RawURL, "aGk_", hi},
+			{EncodingBase32, "NBUT6===", hi},
+		}
+		for _, test := range tests {
+			c := Conv{Encoding: test.enc}
+			got, err := c.Bytes(test.from)
+			if err != nil {
+				t.Errorf("Bytes(%v) unexpected err: %v", test.from, err)
+			} else if !bytes.Equal(got, test.exp) {
+				t.Errorf("Bytes(%v) exp %q; got %q", test.from, test.exp, got)
+			}
+		}
+	})
+	t.Run("BytesErrors", func(t *testing.T) {
+		tests := []struct {
+			enc  Encoding
+			from interface{}
+		}{
+			{EncodingNone, nil},
+			{EncodingNone, true},
+			{EncodingNone, struct{}{}},
+			{EncodingNone, []string{"hi"}},
+			{EncodingNone, (*string)(nil)},
+			{EncodingNone, (*[]byte)(nil)},
+			{EncodingNone, errReader{}},
+			{EncodingHex, "6869z"},
+			{EncodingHex, "686"},
+			{EncodingBase64, "aGk"},
+			{EncodingBase64, "aGk_"},
+			{EncodingBase64URL, "aGk/"},
+			{EncodingBase64Raw, "aGk="},
+			{EncodingBase32, "nbut6==="},
+			{Encoding(255), "hi"},
+		}
+		for _, test := range tests {
+			c := Conv{Encoding: test.enc}
+			if got, err := c.Bytes(test.from); err == nil {
+				t.Errorf("Bytes(%v) exp err; got %q", test.from, got)
+			}
+		}
+	})
+	t.Run("Copy", func(t *testing.T) {
+		var c Conv
+		from := []byte("hi?")
+		got, err := c.Bytes(from)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got[0] = 'H'
+		if from[0] != 'h' {
+			t.Error("Bytes returned a slice sharing memory with from")
+		}
+	})
+	t.Run("String", func(t *testing.T) {
+		tests := []struct {
+			enc  Encoding
+			from interface{}
+			exp  string
+		}{
+			{EncodingNone, hi, "hi?"},
+			{EncodingNone, [3]byte{'h', 'i', '?'}, "[104 105 63]"},
+			{EncodingHex, hi, "68693f"},
+			{EncodingHex, &hi, "68693f"},
+			{EncodingHex, ulyBytes("hi?"), "68693f"},
+			{EncodingHex, [3]byte{'h', 'i', '?'}, "68693f"},
+			{EncodingHex, &[3]byte{'h', 'i', '?'}, "68693f"},
+			{EncodingHex, "hi?", "hi?"},
+			{EncodingHex, 12, "12"},
+			{EncodingBase64, hi, "aGk/"},
+			{EncodingBase64URL, hi, "aGk_"},
+			{EncodingBase64Raw, []byte("hi"), "aGk"},
+			{EncodingBase64RawURL, hi, "aGk_"},
+			{EncodingBase32, hi, "NBUT6==="},
+		}
+		for _, test := range tests {
+			c := Conv{Encoding: test.enc}
+			got, err := c.String(test.from)
+			if err != nil {
+				t.Errorf("String(%v) unexpected err: %v", test.from, err)
+			} else if got != test.exp {
+				t.Errorf("String(%v) exp %q; got %q", test.from, test.exp, got)
+			}
+		}
+	})
+	t.Run("Infer", func(t *testing.T) {
+		c := Conv{Encoding: EncodingHex}
+		var into []byte
+		if err := c.Infer(&into, "68693f"); err != nil || !bytes.Equal(into, hi) {
+			t.Errorf("Infer exp %q; got %q (%v)", hi, into, err)
+		}
+		var uly ulyBytes
+		if err := c.Infer(&uly, "68693f"); err != nil || !bytes.Equal(uly, hi) {
+			t.Errorf("Infer exp %q; got %q (%v)", hi, uly, err)
+		}
+	})
+}
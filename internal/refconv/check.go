@@ -0,0 +1,325 @@
+package refconv
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/cstockton/go-conv/internal/refutil"
+)
+
+var (
+	typeOfString      = reflect.TypeOf("")
+	typeOfRunes       = reflect.TypeOf([]rune(nil))
+	typeOfTimePtr     = reflect.TypeOf((*time.Time)(nil))
+	typeOfBoolConv    = reflect.TypeOf((*boolConverter)(nil)).Elem()
+	typeOfBytesConv   = reflect.TypeOf((*bytesConverter)(nil)).Elem()
+	typeOfComplexConv = reflect.TypeOf((*complexConverter)(nil)).Elem()
+	typeOfFloatConv   = reflect.TypeOf((*floatConverter)(nil)).Elem()
+	typeOfIntConv     = reflect.TypeOf((*intConverter)(nil)).Elem()
+	typeOfUintConv    = reflect.TypeOf((*uintConverter)(nil)).Elem()
+	typeOfStringConv  = reflect.TypeOf((*stringConverter)(nil)).Elem()
+	typeOfDurConv     = reflect.TypeOf((*durationConverter)(nil)).Elem()
+	typeOfTimeConv    = reflect.TypeOf((*timeConverter)(nil)).Elem()
+	typeOfReader      = reflect.TypeOf((*io.Reader)(nil)).Elem()
+)
+
+// matrixTypes are the types enumerated by Matrix.
+var matrixTypes = []reflect.Type{
+	reflect.TypeOf(false),
+	reflect.TypeOf(int(0)),
+	reflect.TypeOf(int8(0)),
+	reflect.TypeOf(int16(0)),
+	reflect.TypeOf(int32(0)),
+	reflect.TypeOf(int64(0)),
+	reflect.TypeOf(uint(0)),
+	reflect.TypeOf(uint8(0)),
+	reflect.TypeOf(uint16(0)),
+	reflect.TypeOf(uint32(0)),
+	reflect.TypeOf(uint64(0)),
+	reflect.TypeOf(float32(0)),
+	reflect.TypeOf(float64(0)),
+	reflect.TypeOf(complex64(0)),
+	reflect.TypeOf(complex128(0)),
+	reflect.TypeOf(""),
+	typeOfBytes,
+	typeOfDuration,
+	typeOfTime,
+	typeOfDecimal,
+	reflect.TypeOf((*big.Int)(nil)),
+	reflect.TypeOf((*big.Float)(nil)),
+	reflect.TypeOf((*big.Rat)(nil)),
+	reflect.TypeOf([]string(nil)),
+	reflect.TypeOf(map[string]string(nil)),
+	reflect.TypeOf(struct{}{}),
+}
+
+// Conversion is a pair of types returned by Matrix.
+type Conversion struct {
+	From reflect.Type
+	To   reflect.Type
+}
+
+// Matrix returns each pair of the common Go types for which CanConvert
+// returns true, including the builtin scalar types, []byte, time.Duration,
+// time.Time, decimal.Decimal, the math/big types, []string, map[string]string
+// and struct{}.
+func (c Conv) Matrix() []Conversion {
+	var res []Conversion
+	for _, from := range matrixTypes {
+		for _, to := range matrixTypes {
+			if c.CanConvert(from, to) {
+				res = append(res, Conversion{From: from, To: to})
+			}
+		}
+	}
+	return res
+}
+
+// Check returns the error Infer would return when converting from into a
+// value of the type into, without assigning it anywhere. Values such as an
+// io.Reader which are consumed by a conversion are consumed by Check.
+func (c Conv) Check(into reflect.Type, from interface{}) error {
+	if into == nil {
+		return fmt.Errorf("cannot check conversion to nil type for %#v", from)
+	}
+	return c.Infer(reflect.New(into), from)
+}
+
+// CanConvert returns true if some value of the type from may be converted to
+// the type to by Infer, following the same paths as the conversion for each
+// type and the options of this Conv. An interface type may be converted to
+// any type which some value it could hold may be converted to, as that value
+// is only known at runtime.
+func (c Conv) CanConvert(from, to reflect.Type) bool {
+	if from == nil || to == nil {
+		return false
+	}
+	if from.Kind() == reflect.Interface {
+		return c.canInterface(to)
+	}
+
+	switch to.Kind() {
+	case reflect.Bool:
+		return c.canBool(from, to)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return c.canNumber(from, to, typeOfIntConv)
+	case reflect.Int64:
+		if to == typeOfDuration {
+			return c.canDuration(from, to)
+		}
+		return c.canNumber(from, to, typeOfIntConv)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+		return c.canNumber(from, to, typeOfUintConv)
+	case reflect.Float32, reflect.Float64:
+		return c.canNumber(from, to, typeOfFloatConv)
+	case reflect.Complex64, reflect.Complex128:
+		return c.canComplex(from, to)
+	case reflect.String:
+		return true
+	case reflect.Interface:
+		return from.AssignableTo(to)
+	case reflect.Slice:
+		if to.Elem().Kind() == reflect.Uint8 {
+			return c.canBytes(from)
+		}
+		base := indirectType(from)
+		return (base.Kind() == reflect.Array || base.Kind() == reflect.Slice) &&
+			c.CanConvert(base.Elem(), to.Elem())
+	case reflect.Map:
+		base := indirectType(from)
+		return base.Kind() == reflect.Map &&
+			c.CanConvert(base.Key(), to.Key()) &&
+			c.CanConvert(base.Elem(), to.Elem())
+	case reflect.Ptr:
+		switch to.Elem() {
+		case typeOfBigInt, typeOfBigFloat, typeOfBigRat:
+			return c.canBig(from, to)
+		}
+		return c.CanConvert(from, to.Elem())
+	case reflect.Struct:
+		switch to {
+		case typeOfTime:
+			return c.canTime(from, to)
+		case typeOfDecimal, typeOfBigInt, typeOfBigFloat, typeOfBigRat:
+			return c.canBig(from, to)
+		}
+	}
+	return false
+}
+
+// canInterface returns true if a value held by an interface may be converted
+// to the type to, which is the case when some concrete type may be.
+func (c *Conv) canInterface(to reflect.Type) bool {
+	switch to.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Slice:
+		return to.Elem().Kind() == reflect.Uint8 ||
+			(to.Elem() != to && c.canInterface(to.Elem()))
+	case reflect.Map:
+		return to.Key() != to && to.Elem() != to &&
+			c.canInterface(to.Key()) && c.canInterface(to.Elem())
+	case reflect.Ptr:
+		return to.Elem() != to && c.canInterface(to.Elem())
+	}
+	return c.CanConvert(typeOfString, to)
+}
+
+// indirectType returns the type reached by dereferencing t until it is not a
+// pointer.
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr && t.Elem() != t {
+		t = t.Elem()
+	}
+	return t
+}
+
+// isBigType returns true if t is one of the math/big types or decimal.Decimal,
+// or a pointer to one.
+func isBigType(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t {
+	case typeOfBigInt, typeOfBigFloat, typeOfBigRat, typeOfDecimal:
+		return true
+	}
+	return false
+}
+
+// canLength returns true if the collection type base may be converted to the
+// type to by the Length mode of this Conv.
+func (c *Conv) canLength(base, to reflect.Type) bool {
+	switch {
+	case c.Length == LengthDefault && !c.Strict:
+		return true
+	case c.Length != LengthUnwrap:
+		return false
+	}
+	switch base.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Map:
+		return c.CanConvert(base.Elem(), to)
+	}
+	return false
+}
+
+func (c *Conv) canBool(from, to reflect.Type) bool {
+	if from.Implements(typeOfBoolConv) {
+		return true
+	}
+	base := indirectType(from)
+	switch kind := base.Kind(); {
+	case reflect.String == kind, reflect.Bool == kind:
+		return true
+	case refutil.IsKindNumeric(kind):
+		return !c.Strict
+	case refutil.IsKindLength(kind):
+		return c.canLength(base, to)
+	}
+	return base == typeOfTime && !c.Strict
+}
+
+func (c *Conv) canNumber(from, to, iface reflect.Type) bool {
+	if from.Implements(iface) || isBigType(from) {
+		return true
+	}
+	base := indirectType(from)
+	switch kind := base.Kind(); {
+	case reflect.String == kind, refutil.IsKindNumeric(kind):
+		return true
+	case reflect.Bool == kind:
+		return !c.Strict
+	case c.Binary != BinaryNone && (kind == reflect.Array || kind == reflect.Slice) &&
+		base.Elem().Kind() == reflect.Uint8:
+		return true
+	case refutil.IsKindLength(kind):
+		return c.canLength(base, to)
+	}
+	return false
+}
+
+func (c *Conv) canComplex(from, to reflect.Type) bool {
+	if from.Implements(typeOfComplexConv) {
+		return true
+	}
+	base := indirectType(from)
+	switch kind := base.Kind(); {
+	case reflect.String == kind, refutil.IsKindNumeric(kind):
+		return true
+	case reflect.Bool == kind:
+		return !c.Strict
+	case refutil.IsKindLength(kind):
+		return c.canLength(base, to)
+	}
+	return false
+}
+
+func (c *Conv) canBig(from, to reflect.Type) bool {
+	if isBigType(from) {
+		return true
+	}
+	base := indirectType(from)
+	switch kind := base.Kind(); {
+	case reflect.String == kind, refutil.IsKindNumeric(kind):
+		return true
+	case reflect.Bool == kind:
+		return !c.Strict
+	case refutil.IsKindLength(kind):
+		return c.canLength(base, to)
+	}
+	return false
+}
+
+func (c *Conv) canBytes(from reflect.Type) bool {
+	switch {
+	case from == typeOfRunes, from.Implements(typeOfBytesConv),
+		from.Implements(typeOfReader), from.Implements(typeOfStringConv):
+		return true
+	}
+	base := indirectType(from)
+	switch kind := base.Kind(); {
+	case reflect.String == kind, refutil.IsKindNumeric(kind):
+		return true
+	case reflect.Array == kind, reflect.Slice == kind:
+		return base.Elem().Kind() == reflect.Uint8
+	}
+	return false
+}
+
+func (c *Conv) canDuration(from, to reflect.Type) bool {
+	if from.Implements(typeOfDurConv) {
+		return true
+	}
+	base := indirectType(from)
+	switch kind := base.Kind(); {
+	case reflect.String == kind, refutil.IsKindNumeric(kind):
+		return true
+	case refutil.IsKindLength(kind):
+		return c.Length == LengthUnwrap && c.canLength(base, to)
+	}
+	return false
+}
+
+func (c *Conv) canTime(from, to reflect.Type) bool {
+	if from == typeOfTime || from == typeOfTimePtr || from.Implements(typeOfTimeConv) {
+		return true
+	}
+	base := indirectType(from)
+	switch kind := base.Kind(); {
+	case reflect.String == kind:
+		return true
+	case reflect.Struct == kind:
+		if base.ConvertibleTo(typeOfTime) {
+			return true
+		}
+		field, ok := base.FieldByName("Time")
+		return ok && field.PkgPath == "" && !c.Strict && c.CanConvert(field.Type, to)
+	case refutil.IsKindLength(kind):
+		return c.Length == LengthUnwrap && c.canLength(base, to)
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package refconv
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cstockton/go-conv/decimal"
+)
+
+func TestCanConvert(t *testing.T) {
+	type T struct{ Time string }
+	of := reflect.TypeOf
+	iface := of((*interface{})(nil)).Elem()
+	tests := []struct {
+		c    Conv
+		from reflect.Type
+		to   reflect.Type
+		exp  bool
+	}{
+		{Conv{}, of(""), of(0), true},
+		{Conv{}, of(true), of(0), true},
+		{Conv{Strict: true}, of(true), of(0), false},
+		{Conv{}, of([]int{}), of(0), true},
+		{Conv{Strict: true}, of([]int{}), of(0), false},
+		{Conv{Length: LengthUnwrap}, of([]int{}), of(0), true},
+		{Conv{Length: LengthUnwrap}, of([]struct{}{}), of(0), false},
+		{Conv{Length: LengthError}, of([]int{}), of(0), false},
+		{Conv{}, of([]int{}), of(time.Duration(0)), false},
+		{Conv{}, of(struct{}{}), of(0), false},
+		{Conv{}, of(new(int)), of(0), true},
+		{Conv{}, iface, of(struct{}{}), false},
+		{Conv{}, iface, of(T{}), false},
+		{Conv{}, iface, of(make(chan int)), false},
+		{Conv{}, iface, of(new(struct{})), false},
+		{Conv{}, iface, of([]struct{}{}), false},
+		{Conv{}, iface, of(map[string]struct{}{}), false},
+		{Conv{}, iface, of(0), true},
+		{Conv{}, iface, of(time.Time{}), true},
+		{Conv{}, iface, of(&big.Int{}), true},
+		{Conv{}, iface, of([]byte{}), true},
+		{Conv{}, iface, of([]int{}), true},
+		{Conv{}, iface, of(map[string]int{}), true},
+		{Conv{}, iface, of((*fmt.Stringer)(nil)).Elem(), true},
+		{Conv{}, iface, iface, true},
+		{Conv{}, of(struct{}{}), of(""), true},
+		{Conv{}, of(T{}), of(time.Time{}), true},
+		{Conv{Strict: true}, of(T{}), of(time.Time{}), false},
+		{Conv{}, of(time.Time{}), of(true), true},
+		{Conv{}, of(time.Time{}), of(0), false},
+		{Conv{}, of([]byte{}), of(0), true},
+		{Conv{Length: LengthError}, of([]byte{}), of(0), false},
+		{Conv{Length: LengthError, Binary: BinaryBigEndian}, of([]byte{}), of(0), true},
+		{Conv{}, of(&big.Int{}), of(0), true},
+		{Conv{}, of(decimal.Decimal{}), of(0.0), true},
+		{Conv{}, of(&big.Int{}), of(complex128(0)), false},
+		{Conv{}, of(1.5), of(&big.Rat{}), true},
+		{Conv{}, of(struct{}{}), of(&big.Rat{}), false},
+		{Conv{}, of([]rune{}), of([]byte{}), true},
+		{Conv{}, of(strings.NewReader("")), of([]byte{}), true},
+		{Conv{}, of([]int{}), of([]byte{}), false},
+		{Conv{}, of([]int{}), of([]string{}), true},
+		{Conv{}, of([]struct{}{}), of([]int{}), false},
+		{Conv{}, of(map[string]int{}), of(map[int]string{}), true},
+		{Conv{}, of(map[string]struct{}{}), of(map[int]int{}), false},
+		{Conv{}, of(""), of(new(int)), true},
+		{Conv{}, of(""), of(struct{}{}), false},
+		{Conv{}, of(0), of((*interface{})(nil)).Elem(), true},
+		{Conv{}, of(time.Second), of((*fmt.Stringer)(nil)).Elem(), true},
+		{Conv{}, of(0), of((*fmt.Stringer)(nil)).Elem(), false},
+		{Conv{}, nil, of(0), false},
+	}
+	for _, test := range tests {
+		if got := test.c.CanConvert(test.from, test.to); got != test.exp {
+			t.Errorf("%+v CanConvert(%v, %v) exp %v; got %v",
+				test.c, test.from, test.to, test.exp, got)
+		}
+	}
+
+	// Every successful conversion must be reported by CanConvert.
+	samples := []interface{}{
+		true, int(1), int8(1), int16(1), int32(1), int64(1), uint(1), uint8(1),
+		uint16(1), uint32(1), uint64(1), float32(1), float64(1), complex64(1),
+		complex128(1), "1", "true", "1s", "2006-01-02T15:04:05Z", []byte("1"),
+		time.Second, time.Unix(1, 0), decimal.Decimal{Coef: 1}, big.NewInt(1),
+		big.NewFloat(1), big.NewRat(1, 1), []string{"1"}, map[string]string{"1": "1"},
+		struct{}{}, T{"2006-01-02T15:04:05Z"}, []rune("1"), new(int),
+	}
+	convs := []Conv{
+		{}, {Strict: true}, {Length: LengthUnwrap}, {Length: LengthError},
+		{Binary: BinaryBigEndian}, {Strict: true, Length: LengthUnwrap},
+	}
+	for _, c := range convs {
+		for _, from := range samples {
+			for _, to := range matrixTypes {
+				if c.Check(to, from) != nil {
+					continue
+				}
+				if !c.CanConvert(reflect.TypeOf(from), to) {
+					t.Errorf("%+v CanConvert(%T, %v) exp true", c, from, to)
+				}
+				if !c.CanConvert(iface, to) {
+					t.Errorf("%+v CanConvert(interface {}, %v) exp true", c, to)
+				}
+			}
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	var c Conv
+	if err := c.Check(reflect.TypeOf(0), "12"); err != nil {
+		t.Errorf("Check exp nil err; got %v", err)
+	}
+	if err := c.Check(reflect.TypeOf(0), "abc"); err == nil {
+		t.Error("Check exp non-nil err")
+	}
+	if err := c.Check(nil, "12"); err == nil {
+		t.Error("Check exp non-nil err for nil type")
+	}
+}
+
+func TestMatrix(t *testing.T) {
+	var c Conv
+	got := c.Matrix()
+	if len(got) == 0 {
+		t.Fatal("Matrix exp non-empty result")
+	}
+	for _, conv := range got {
+		if !c.CanConvert(conv.From, conv.To) {
+			t.Errorf("Matrix exp only convertible pairs; got %v", conv)
+		}
+	}
+	if strict := (Conv{Strict: true}).Matrix(); len(strict) >= len(got) {
+		t.Errorf("Matrix exp fewer pairs when Strict; got %v >= %v",
+			len(strict), len(got))
+	}
+}
@@ -0,0 +1,123 @@
+package refconv
+
+import (
+	"reflect"
+
+	"github.com/cstockton/go-conv/internal/refutil"
+)
+
+// inferSet converts from into the type of the settable value and assigns it,
+// results are converted when value is a named type such as `type ID int64`.
+func (c *Conv) inferSet(value reflect.Value, from interface{}) error {
+	if value.Kind() == reflect.Interface {
+		if from == nil {
+			value.Set(reflect.Zero(value.Type()))
+			return nil
+		}
+		if res := reflect.ValueOf(from); res.Type().AssignableTo(value.Type()) {
+			value.Set(res)
+			return nil
+		}
+	}
+
+	v, err := c.infer(value, from)
+	if err != nil {
+		return err
+	}
+
+	res := reflect.ValueOf(v)
+	if res.Type() != value.Type() {
+		if !res.Type().ConvertibleTo(value.Type()) {
+			return newConvErr(from, value.Type().String())
+		}
+		res = res.Convert(value.Type())
+	}
+	value.Set(res)
+	return nil
+}
+
+// isNil returns true if from is a nil interface or pointer.
+func isNil(from interface{}) bool {
+	value := refutil.IndirectVal(reflect.ValueOf(from))
+	return !value.IsValid() || (value.Kind() == reflect.Ptr && value.IsNil())
+}
+
+// convPtr converts from into a new value of the element type of typ and
+// returns a pointer to it, nil values are returned as a nil pointer.
+func (c *Conv) convPtr(typ reflect.Type, from interface{}) (interface{}, error) {
+	if isNil(from) {
+		return reflect.Zero(typ).Interface(), nil
+	}
+
+	ptr := reflect.New(typ.Elem())
+	if err := c.inferSet(ptr.Elem(), from); err != nil {
+		return nil, err
+	}
+	return ptr.Interface(), nil
+}
+
+// convSlice converts each element of the array or slice from into a new slice
+// of type typ, nil values are returned as a nil slice.
+func (c *Conv) convSlice(typ reflect.Type, from interface{}) (interface{}, error) {
+	if isNil(from) {
+		return reflect.Zero(typ).Interface(), nil
+	}
+
+	value := refutil.IndirectVal(reflect.ValueOf(from))
+	switch value.Kind() {
+	case reflect.Slice:
+		if value.IsNil() {
+			return reflect.Zero(typ).Interface(), nil
+		}
+	case reflect.Array:
+	default:
+		return nil, newConvErr(from, typ.String())
+	}
+
+	res := reflect.MakeSlice(typ, value.Len(), value.Len())
+	for i := 0; i < value.Len(); i++ {
+		elem := value.Index(i)
+		if !elem.CanInterface() {
+			return nil, newConvErr(from, typ.String())
+		}
+		if err := c.inferSet(res.Index(i), elem.Interface()); err != nil {
+			return nil, newConvErrReason(from, typ.String(), err)
+		}
+	}
+	return res.Interface(), nil
+}
+
+// convMap converts each key and value of the map from into a new map of type
+// typ, nil values are returned as a nil map.
+func (c *Conv) convMap(typ reflect.Type, from interface{}) (interface{}, error) {
+	if isNil(from) {
+		return reflect.Zero(typ).Interface(), nil
+	}
+
+	value := refutil.IndirectVal(reflect.ValueOf(from))
+	if value.Kind() != reflect.Map {
+		return nil, newConvErr(from, typ.String())
+	} else if value.IsNil() {
+		return reflect.Zero(typ).Interface(), nil
+	}
+
+	res := reflect.MakeMapWithSize(typ, value.Len())
+	iter := value.MapRange()
+	for iter.Next() {
+		k, v := iter.Key(), iter.Value()
+		if !k.CanInterface() || !v.CanInterface() {
+			return nil, newConvErr(from, typ.String())
+		}
+
+		key := reflect.New(typ.Key()).Elem()
+		if err := c.inferSet(key, k.Interface()); err != nil {
+			return nil, newConvErrReason(from, typ.String(), err)
+		}
+		elem := reflect.New(typ.Elem()).Elem()
+		if err := c.inferSet(elem, v.Interface()); err != nil {
+			return nil, newConvErrReason(from, typ.String(), err)
+		}
+		res.SetMapIndex(key, elem)
+	}
+	return res.Interface(), nil
+}
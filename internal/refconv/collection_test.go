@@ -0,0 +1,101 @@
+package refconv
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCollection(t *testing.T) {
+	type ID int64
+	type Name string
+	var c Conv
+
+	t.Run("Named", func(t *testing.T) {
+		var id ID
+		if err := c.Infer(&id, "42"); err != nil || id != 42 {
+			t.Errorf("Infer exp 42; got %v (%v)", id, err)
+		}
+		var name Name
+		if err := c.Infer(&name, 42); err != nil || name != "42" {
+			t.Errorf("Infer exp 42; got %v (%v)", name, err)
+		}
+	})
+	t.Run("Ptr", func(t *testing.T) {
+		var ip *int
+		if err := c.Infer(&ip, "42"); err != nil || ip == nil || *ip != 42 {
+			t.Errorf("Infer exp 42; got %v (%v)", ip, err)
+		}
+		var dpp **time.Duration
+		if err := c.Infer(&dpp, "1s"); err != nil || dpp == nil || **dpp != time.Second {
+			t.Errorf("Infer exp 1s; got %v (%v)", dpp, err)
+		}
+		if err := c.Infer(&ip, nil); err != nil || ip != nil {
+			t.Errorf("Infer exp nil; got %v (%v)", ip, err)
+		}
+		if err := c.Infer(&ip, "foo"); err == nil {
+			t.Error("Infer exp err")
+		}
+	})
+	t.Run("Slice", func(t *testing.T) {
+		tests := []struct {
+			into interface{}
+			from interface{}
+			exp  interface{}
+		}{
+			{new([]int), []string{"1", "2"}, []int{1, 2}},
+			{new([]int), [2]interface{}{"1", 2.5}, []int{1, 2}},
+			{new([]ID), []string{"7"}, []ID{7}},
+			{new([]string), &[]int{1}, []string{"1"}},
+			{new([][]bool), [][]string{{"t", "f"}}, [][]bool{{true, false}}},
+			{new([]*int), []string{"3"}, []*int{new(int)}},
+			{new([]int), []string(nil), []int(nil)},
+			{new([]int), nil, []int(nil)},
+		}
+		for _, test := range tests {
+			into := reflect.ValueOf(test.into)
+			if err := c.Infer(test.into, test.from); err != nil {
+				t.Errorf("Infer(%#v) unexpected err: %v", test.from, err)
+				continue
+			}
+			got := into.Elem().Interface()
+			if ps, ok := got.([]*int); ok {
+				if len(ps) != 1 || *ps[0] != 3 {
+					t.Errorf("Infer(%#v) exp [3]; got %v", test.from, ps)
+				}
+			} else if !reflect.DeepEqual(got, test.exp) {
+				t.Errorf("Infer(%#v) exp %#v; got %#v", test.from, test.exp, got)
+			}
+		}
+		var into []int
+		for _, from := range []interface{}{"1", 1, []string{"foo"}, map[int]int{}} {
+			if err := c.Infer(&into, from); err == nil {
+				t.Errorf("Infer(%#v) exp err; got %v", from, into)
+			}
+		}
+	})
+	t.Run("Map", func(t *testing.T) {
+		var into map[string]int
+		from := map[interface{}]interface{}{1: "2", "k": 3.5}
+		if err := c.Infer(&into, from); err != nil {
+			t.Fatalf("Infer(%#v) unexpected err: %v", from, err)
+		}
+		exp := map[string]int{"1": 2, "k": 3}
+		if !reflect.DeepEqual(into, exp) {
+			t.Errorf("Infer(%#v) exp %#v; got %#v", from, exp, into)
+		}
+
+		var ids map[ID][]ID
+		if err := c.Infer(&ids, map[string][]string{"1": {"2", "3"}}); err != nil {
+			t.Fatalf("Infer unexpected err: %v", err)
+		} else if !reflect.DeepEqual(ids, map[ID][]ID{1: {2, 3}}) {
+			t.Errorf("Infer exp map[1:[2 3]]; got %v", ids)
+		}
+		if err := c.Infer(&into, map[string]string{"a": "foo"}); err == nil {
+			t.Errorf("Infer exp err; got %v", into)
+		}
+		if err := c.Infer(&into, []int{1}); err == nil {
+			t.Errorf("Infer exp err; got %v", into)
+		}
+	})
+}
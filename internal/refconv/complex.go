@@ -0,0 +1,151 @@
+package refconv
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/cstockton/go-conv/internal/refutil"
+)
+
+var errImaginary = errors.New("value has a non-zero imaginary part")
+
+func (c *Conv) convStrToComplex128(v string) (complex128, bool) {
+	v, err := c.preprocess(v)
+	if err != nil {
+		return 0, false
+	}
+	// Real numbers are left to convStrToFloat64 when they may be localized, so
+	// "1.234" is 1234 rather than 1.234 in LocaleDE.
+	localized := c.isLocalized()
+	if !localized || strings.ContainsAny(v, "iI") {
+		if parsed, err := strconv.ParseComplex(v, 128); err == nil {
+			if c.Trace != nil {
+				c.tracef("parsed %q as a complex number", v)
+			}
+			return parsed, true
+		}
+	}
+	if c.Strict && !localized {
+		return 0, false
+	}
+	if parsed, ok := c.convStrToFloat64(v); ok {
+		return complex(parsed, 0), true
+	}
+	return 0, false
+}
+
+// convComplexToReal returns the real part of v, if StrictImaginary is set an
+// error is returned when the imaginary part is non-zero.
+func (c *Conv) convComplexToReal(v complex128) (float64, error) {
+	if c.StrictImaginary && imag(v) != 0 {
+		return 0, errImaginary
+	} else if imag(v) != 0 {
+		c.tracef("dropped the imaginary part of %v", v)
+	}
+	return real(v), nil
+}
+
+type complexConverter interface {
+	Complex128() (complex128, error)
+}
+
+// Complex128 attempts to convert the given value to complex128, returns the
+// zero value and an error on failure. Real numbers are given an imaginary part
+// of zero.
+func (c Conv) Complex128(from interface{}) (complex128, error) {
+	if empty, err := c.convEmpty(from, "complex128"); empty {
+		return 0, err
+	}
+	if T, ok := from.(complex128); ok {
+		return c.convComplex128(from, T)
+	}
+	if T, ok := from.(complexConverter); ok {
+		c.traceHook(from, "Complex128")
+		return T.Complex128()
+	}
+
+	value := c.indirect(from)
+	kind := value.Kind()
+	switch {
+	case reflect.String == kind:
+		if parsed, ok := c.convStrToComplex128(value.String()); ok {
+			return c.convComplex128(from, parsed)
+		} else if c.Strict {
+			return 0, newConvErrReason(from, "complex128", ErrSyntax)
+		}
+	case refutil.IsKindInt(kind):
+		return complex(float64(value.Int()), 0), nil
+	case refutil.IsKindUint(kind):
+		return complex(float64(value.Uint()), 0), nil
+	case refutil.IsKindFloat(kind):
+		return c.convComplex128(from, complex(value.Float(), 0))
+	case refutil.IsKindComplex(kind):
+		return c.convComplex128(from, value.Complex())
+	case reflect.Bool == kind:
+		if c.Strict {
+			return 0, newConvErrReason(from, "complex128", ErrUnsupported)
+		}
+		if value.Bool() {
+			return 1, nil
+		}
+		return 0, nil
+	case refutil.IsKindLength(kind):
+		if c.Length == LengthDefault && !c.Strict {
+			c.tracef("converted %v to its length %d", value.Type(), value.Len())
+			return complex(float64(value.Len()), 0), nil
+		}
+		elem, err := c.convUnwrap(value)
+		if err != nil {
+			return 0, newConvErrReason(from, "complex128", err)
+		}
+		return c.Complex128(elem)
+	}
+	return 0, c.newUnsupportedErr(from, "complex128")
+}
+
+// Complex64 attempts to convert the given value to complex64, returns the zero
+// value and an error on failure. Each part is clamped to the range of a
+// float32 in the same way as Float32.
+func (c Conv) Complex64(from interface{}) (complex64, error) {
+	if T, ok := from.(complex64); ok && c.NonFinite == NonFiniteDefault {
+		return T, nil
+	}
+
+	res, err := c.Complex128(from)
+	if err != nil {
+		return 0, newConvErrFrom(from, "complex64", err)
+	}
+	return complex(c.convClampFloat32(real(res)),
+		c.convClampFloat32(imag(res))), nil
+}
+
+func (c *Conv) convComplex128(from interface{}, v complex128) (complex128, error) {
+	re, err := c.convNonFinite(real(v), true)
+	if err != nil {
+		return 0, newConvErrReason(from, "complex128", err)
+	}
+	im, err := c.convNonFinite(imag(v), true)
+	if err != nil {
+		return 0, newConvErrReason(from, "complex128", err)
+	}
+	return complex(re, im), nil
+}
+
+// convClampFloat32 clamps f to the range of a float32, infinite values are
+// only kept when NonFinite is NonFinitePass.
+func (c *Conv) convClampFloat32(f float64) float32 {
+	if math.IsInf(f, 0) && c.NonFinite == NonFinitePass {
+		return float32(f)
+	}
+	if f > math.MaxFloat32 {
+		c.tracef("clamped %v to the range of float32", f)
+		f = math.MaxFloat32
+	} else if f < -math.MaxFloat32 {
+		c.tracef("clamped %v to the range of float32", f)
+		f = -math.MaxFloat32
+	}
+	return float32(f)
+}
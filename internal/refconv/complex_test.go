@@ -0,0 +1,104 @@
+package refconv
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+	"time"
+)
+
+func TestComplex(t *testing.T) {
+	t.Run("StrictImaginary", func(t *testing.T) {
+		var c Conv
+		if got, err := c.Int64(2 + 3i); err != nil || got != 2 {
+			t.Errorf("Int64 exp 2; got %v (%v)", got, err)
+		}
+
+		c = Conv{StrictImaginary: true}
+		if got, err := c.Int64(2 + 0i); err != nil || got != 2 {
+			t.Errorf("Int64 exp 2; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64(2 + 3i); err == nil {
+			t.Errorf("Int64 exp err; got %v", got)
+		}
+		if got, err := c.Uint64(2 + 3i); err == nil {
+			t.Errorf("Uint64 exp err; got %v", got)
+		}
+		if got, err := c.Float64(complex64(2 + 3i)); err == nil {
+			t.Errorf("Float64 exp err; got %v", got)
+		}
+		if got, err := c.Float32(2 + 3i); err == nil {
+			t.Errorf("Float32 exp err; got %v", got)
+		}
+		if got, err := c.Bool(2 + 3i); err == nil {
+			t.Errorf("Bool exp err; got %v", got)
+		}
+		if got, err := c.Duration(2 + 3i); err == nil {
+			t.Errorf("Duration exp err; got %v", got)
+		}
+		if got, err := c.Duration(2 + 0i); err != nil || got != 2*time.Second {
+			t.Errorf("Duration exp 2s; got %v (%v)", got, err)
+		}
+		if got, err := c.Complex128(2 + 3i); err != nil || got != 2+3i {
+			t.Errorf("Complex128 exp 2+3i; got %v (%v)", got, err)
+		}
+	})
+	t.Run("NonFinite", func(t *testing.T) {
+		inf, nan := math.Inf(1), math.NaN()
+
+		var c Conv
+		if got, err := c.Complex128(complex(inf, nan)); err != nil ||
+			!math.IsInf(real(got), 1) || !math.IsNaN(imag(got)) {
+			t.Errorf("Complex128 exp (+Inf+NaNi); got %v (%v)", got, err)
+		}
+		if got, err := c.Complex64(complex(inf, 0)); err != nil ||
+			got != complex(math.MaxFloat32, 0) {
+			t.Errorf("Complex64 exp MaxFloat32; got %v (%v)", got, err)
+		}
+
+		c = Conv{NonFinite: NonFiniteError}
+		if got, err := c.Complex128(complex(1, nan)); err == nil {
+			t.Errorf("Complex128 exp err; got %v", got)
+		}
+		if got, err := c.Complex128(inf); err == nil {
+			t.Errorf("Complex128 exp err; got %v", got)
+		}
+		if got, err := c.Complex64(complex64(complex(inf, 0))); err == nil {
+			t.Errorf("Complex64 exp err; got %v", got)
+		}
+		if got, err := c.Complex128("NaN"); err == nil {
+			t.Errorf("Complex128 exp err; got %v", got)
+		}
+
+		c = Conv{NonFinite: NonFiniteSaturate}
+		if got, err := c.Complex64(complex(-inf, nan)); err != nil ||
+			got != complex(-math.MaxFloat32, 0) {
+			t.Errorf("Complex64 exp -MaxFloat32; got %v (%v)", got, err)
+		}
+
+		c = Conv{NonFinite: NonFinitePass}
+		if got, err := c.Complex64(complex(inf, -inf)); err != nil ||
+			!cmplx.IsInf(complex128(got)) {
+			t.Errorf("Complex64 exp Inf; got %v (%v)", got, err)
+		}
+	})
+	t.Run("Locale", func(t *testing.T) {
+		c := Conv{Locale: LocaleDE}
+		if got, err := c.Complex128("1.234,5"); err != nil || got != 1234.5 {
+			t.Errorf("Complex128 exp 1234.5; got %v (%v)", got, err)
+		}
+		if got, err := c.Complex128("1.234"); err != nil || got != 1234 {
+			t.Errorf("Complex128 exp 1234; got %v (%v)", got, err)
+		}
+		if got, err := c.Complex128("1+2i"); err != nil || got != 1+2i {
+			t.Errorf("Complex128 exp (1+2i); got %v (%v)", got, err)
+		}
+		c.Strict = true
+		if got, err := c.Complex128("1.234"); err != nil || got != 1234 {
+			t.Errorf("Complex128 exp 1234; got %v (%v)", got, err)
+		}
+		if got, err := c.Complex128("true"); err == nil {
+			t.Errorf("Complex128 exp err; got %v", got)
+		}
+	})
+}
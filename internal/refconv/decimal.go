@@ -0,0 +1,102 @@
+package refconv
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/cstockton/go-conv/decimal"
+	"github.com/cstockton/go-conv/internal/refutil"
+)
+
+var (
+	typeOfDecimal = reflect.TypeOf(decimal.Decimal{})
+	errInexact    = errors.New("value has no exact decimal representation")
+)
+
+// Decimal attempts to convert the given value to a decimal.Decimal, returns
+// the zero value and an error on failure. Numeric strings are parsed exactly
+// and floats use the shortest decimal that represents them, i.e. 0.1 is 0.1
+// rather than 0.1000000000000000055511151231257827. When DecimalScale is set
+// the value is rounded to that scale by the rounding mode of this Conv.
+func (c Conv) Decimal(from interface{}) (decimal.Decimal, error) {
+	if empty, err := c.convEmpty(from, "decimal.Decimal"); empty {
+		return decimal.Decimal{}, err
+	}
+	if T, ok := from.(decimal.Decimal); ok && c.DecimalScale == 0 {
+		return T, nil
+	}
+
+	var (
+		r   *big.Rat
+		err error
+	)
+	value := c.indirect(from)
+	switch kind := value.Kind(); {
+	case refutil.IsKindFloat(kind):
+		r, err = c.convFloatToRat(from, value.Float(), value.Type().Bits())
+	case refutil.IsKindComplex(kind):
+		var f float64
+		if f, err = c.convComplexToReal(value.Complex()); err != nil {
+			return decimal.Decimal{}, newConvErrReason(from, "decimal.Decimal", err)
+		}
+		r, err = c.convFloatToRat(from, f, value.Type().Bits()/2)
+	default:
+		r, err = c.convBigRat(from, "decimal.Decimal")
+	}
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	d, err := c.convRatToDecimal(r)
+	if err != nil {
+		return decimal.Decimal{}, newConvErrReason(from, "decimal.Decimal", err)
+	}
+	return d, nil
+}
+
+// convFloatToRat returns the shortest decimal that represents f as a *big.Rat.
+func (c *Conv) convFloatToRat(
+	from interface{}, f float64, bits int) (*big.Rat, error) {
+	f, err := c.convNonFiniteBig(f, false)
+	if err != nil {
+		return nil, newConvErrReason(from, "decimal.Decimal", err)
+	}
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'e', -1, bits))
+	return r, nil
+}
+
+// convRatToDecimal returns r as a decimal.Decimal with the DecimalScale of this
+// Conv, or the smallest scale which represents r exactly when it is zero.
+func (c *Conv) convRatToDecimal(r *big.Rat) (decimal.Decimal, error) {
+	scale := c.DecimalScale
+	if scale == 0 {
+		n, ok := ratDigits(r)
+		if !ok {
+			return decimal.Decimal{}, errInexact
+		}
+		scale = int32(n)
+	}
+
+	abs := int64(scale)
+	if abs < 0 {
+		abs = -abs
+	}
+	pow := new(big.Rat).SetInt(
+		new(big.Int).Exp(big.NewInt(10), big.NewInt(abs), nil))
+	if scale > 0 {
+		r = new(big.Rat).Mul(r, pow)
+	} else {
+		r = new(big.Rat).Quo(r, pow)
+	}
+
+	coef, err := c.roundRat(r)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if !coef.IsInt64() {
+		return decimal.Decimal{}, strconv.ErrRange
+	}
+	return decimal.Decimal{Coef: coef.Int64(), Scale: scale}, nil
+}
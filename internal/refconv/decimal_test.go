@@ -0,0 +1,130 @@
+package refconv
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/cstockton/go-conv/decimal"
+)
+
+func TestDecimal(t *testing.T) {
+	dec := func(coef int64, scale int32) decimal.Decimal {
+		return decimal.Decimal{Coef: coef, Scale: scale}
+	}
+
+	t.Run("Decimal", func(t *testing.T) {
+		tests := []struct {
+			c    Conv
+			from interface{}
+			exp  decimal.Decimal
+		}{
+			{Conv{}, "12.34", dec(1234, 2)},
+			{Conv{}, "-0.05", dec(-5, 2)},
+			{Conv{}, "12.000", dec(12, 0)},
+			{Conv{}, "1.5e3", dec(1500, 0)},
+			{Conv{}, "1.5e-3", dec(15, 4)},
+			{Conv{}, "9223372036854775807", dec(math.MaxInt64, 0)},
+			{Conv{}, "true", dec(1, 0)},
+			{Conv{}, 12, dec(12, 0)},
+			{Conv{}, uint8(12), dec(12, 0)},
+			{Conv{}, 0.1, dec(1, 1)},
+			{Conv{}, 0.29, dec(29, 2)},
+			{Conv{}, float32(0.1), dec(1, 1)},
+			{Conv{}, complex(2.5, 0), dec(25, 1)},
+			{Conv{}, complex64(complex(0.1, 0)), dec(1, 1)},
+			{Conv{}, true, dec(1, 0)},
+			{Conv{}, big.NewRat(1, 8), dec(125, 3)},
+			{Conv{}, big.NewInt(-7), dec(-7, 0)},
+			{Conv{}, dec(1234, 2), dec(1234, 2)},
+			{Conv{}, &decimal.Decimal{Coef: 5, Scale: 1}, dec(5, 1)},
+			{Conv{DecimalScale: 2}, "12.345", dec(1234, 2)},
+			{Conv{DecimalScale: 2}, "12", dec(1200, 2)},
+			{Conv{DecimalScale: 2}, 0.29, dec(29, 2)},
+			{Conv{DecimalScale: 2}, 12, dec(1200, 2)},
+			{Conv{DecimalScale: 2}, dec(5, 1), dec(50, 2)},
+			{Conv{DecimalScale: 2}, big.NewRat(1, 3), dec(33, 2)},
+			{Conv{DecimalScale: 2, Rounding: RoundHalfEven}, "0.125", dec(12, 2)},
+			{Conv{DecimalScale: 2, Rounding: RoundHalfUp}, "0.125", dec(13, 2)},
+			{Conv{DecimalScale: 2, Rounding: RoundCeil}, 0.121, dec(13, 2)},
+			{Conv{DecimalScale: -2}, 1234, dec(12, -2)},
+			{Conv{Locale: LocaleDE}, "1.234,56", dec(123456, 2)},
+			{Conv{NonFinite: NonFiniteZero}, math.NaN(), dec(0, 0)},
+		}
+		for _, test := range tests {
+			got, err := test.c.Decimal(test.from)
+			if err != nil {
+				t.Errorf("Decimal(%v) unexpected err: %v", test.from, err)
+			} else if got != test.exp {
+				t.Errorf("Decimal(%v) exp %#v; got %#v", test.from, test.exp, got)
+			}
+		}
+	})
+	t.Run("DecimalErrors", func(t *testing.T) {
+		tests := []struct {
+			c    Conv
+			from interface{}
+		}{
+			{Conv{}, "foo"},
+			{Conv{}, "9223372036854775808"},
+			{Conv{}, "0.123456789012345678901"},
+			{Conv{}, big.NewRat(1, 3)},
+			{Conv{}, uint64(math.MaxUint64)},
+			{Conv{}, math.Inf(1)},
+			{Conv{NonFinite: NonFiniteSaturate}, math.Inf(1)},
+			{Conv{}, "NaN"},
+			{Conv{}, struct{}{}},
+			{Conv{}, (*decimal.Decimal)(nil)},
+			{Conv{StrictImaginary: true}, 1 + 1i},
+			{Conv{DecimalScale: 2, Rounding: RoundError}, "0.125"},
+			{Conv{DecimalScale: 18}, 100},
+			{Conv{DecimalScale: 2}, dec(1, -100000000)},
+			{Conv{DecimalScale: 2}, dec(1, math.MinInt32)},
+			{Conv{DecimalScale: 2}, dec(1, math.MaxInt32)},
+		}
+		for _, test := range tests {
+			if got, err := test.c.Decimal(test.from); err == nil {
+				t.Errorf("Decimal(%v) exp err; got %#v", test.from, got)
+			}
+		}
+	})
+	t.Run("Sources", func(t *testing.T) {
+		var c Conv
+		if got, err := c.Int64(dec(1299, 2)); err != nil || got != 12 {
+			t.Errorf("Int64 exp 12; got %v (%v)", got, err)
+		}
+		if got, err := (Conv{Rounding: RoundHalfUp}).Int64(dec(1250, 2)); err != nil || got != 13 {
+			t.Errorf("Int64 exp 13; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64(&decimal.Decimal{Coef: 5, Scale: -1}); err != nil || got != 50 {
+			t.Errorf("Uint64 exp 50; got %v (%v)", got, err)
+		}
+		if got, err := c.Float64(dec(1234, 2)); err != nil || got != 12.34 {
+			t.Errorf("Float64 exp 12.34; got %v (%v)", got, err)
+		}
+		if got, err := c.String(dec(-1234, 2)); err != nil || got != "-12.34" {
+			t.Errorf("String exp -12.34; got %v (%v)", got, err)
+		}
+		for _, d := range []decimal.Decimal{
+			dec(1, -100000000), dec(1, math.MinInt32), dec(1, math.MaxInt32)} {
+			if got, err := c.Int64(d); !errors.Is(err, strconv.ErrRange) {
+				t.Errorf("Int64(%v) exp range err; got %v (%v)", d, got, err)
+			}
+			if got, err := c.Uint8(&d); !errors.Is(err, strconv.ErrRange) {
+				t.Errorf("Uint8(%v) exp range err; got %v (%v)", d, got, err)
+			}
+			if got, err := c.Float64(d); !errors.Is(err, strconv.ErrRange) {
+				t.Errorf("Float64(%v) exp range err; got %v (%v)", d, got, err)
+			}
+			if got, err := c.BigInt(d); !errors.Is(err, strconv.ErrRange) {
+				t.Errorf("BigInt(%v) exp range err; got %v (%v)", d, got, err)
+			}
+		}
+		var into decimal.Decimal
+		if err := c.Infer(&into, "12.34"); err != nil || into != dec(1234, 2) {
+			t.Errorf("Infer exp 12.34; got %v (%v)", into, err)
+		}
+	})
+}
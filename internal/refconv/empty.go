@@ -0,0 +1,78 @@
+package refconv
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/cstockton/go-conv/internal/refutil"
+)
+
+// EmptyMode controls how nil and empty values are converted, a value is empty
+// when it is a nil interface or pointer, or a string which is empty or one of
+// the literals "null", "nil" or "~".
+type EmptyMode uint8
+
+// Empty modes, the zero value keeps the behavior of each target type.
+const (
+
+	// EmptyDefault keeps the behavior of each target type, for example most
+	// return an error while String formats nil as "<nil>".
+	EmptyDefault EmptyMode = iota
+
+	// EmptyError returns an error for empty values for all targets.
+	EmptyError
+
+	// EmptyZero converts empty values to the zero value of the target type.
+	EmptyZero
+
+	// EmptyKeep is like EmptyZero, except Infer leaves the value it would
+	// convert into unchanged.
+	EmptyKeep
+)
+
+var errEmpty = errors.New("value is empty")
+
+// isEmpty returns true if from is a nil interface or pointer, or a string
+// which is empty or one of the literals "null", "nil" or "~" once the
+// Preprocess chain of this Conv has been applied.
+func (c Conv) isEmpty(from interface{}) bool {
+	value := refutil.IndirectVal(reflect.ValueOf(from))
+	switch value.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	case reflect.String:
+		s := value.String()
+		if v, err := c.preprocess(s); err == nil {
+			s = v
+		}
+		return s == "" || s == "~" || strings.EqualFold(s, "null") ||
+			strings.EqualFold(s, "nil")
+	}
+	return false
+}
+
+// convEmpty returns true if from is empty and the Empty mode of this Conv is
+// set, in which case the caller returns the zero value of its target along
+// with err.
+func (c *Conv) convEmpty(from interface{}, to string) (empty bool, err error) {
+	if c.Empty == EmptyDefault {
+		return false, nil
+	}
+	return c.convEmptyMode(from, to)
+}
+
+// convEmptyMode is convEmpty for a Conv with an Empty mode set, it is kept
+// apart so the check of the default mode is inlined.
+func (c *Conv) convEmptyMode(from interface{}, to string) (empty bool, err error) {
+	if !c.isEmpty(from) {
+		return false, nil
+	}
+	if c.Empty == EmptyError {
+		return true, newConvErrReason(from, to, errEmpty)
+	}
+	c.tracef("%#v is empty, used the zero value of %v", from, to)
+	return true, nil
+}
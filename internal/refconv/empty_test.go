@@ -0,0 +1,131 @@
+package refconv
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestEmpty(t *testing.T) {
+	var nilStr *string
+	empties := []interface{}{nil, nilStr, "", "null", "NULL", "Nil", "~", new(string)}
+
+	t.Run("isEmpty", func(t *testing.T) {
+		var c Conv
+		for _, from := range empties {
+			if !c.isEmpty(from) {
+				t.Errorf("isEmpty(%#v) exp true", from)
+			}
+		}
+		pp := &nilStr
+		if !c.isEmpty(pp) {
+			t.Error("isEmpty(**string) exp true")
+		}
+		for _, from := range []interface{}{0, " ", "nullx", "0", false, []int(nil), struct{}{}} {
+			if c.isEmpty(from) {
+				t.Errorf("isEmpty(%#v) exp false", from)
+			}
+		}
+	})
+	t.Run("Default", func(t *testing.T) {
+		var c Conv
+		if got, err := c.Int(nil); err == nil {
+			t.Errorf("Int exp err; got %v", got)
+		}
+		if got, err := c.String(nil); err != nil || got != "<nil>" {
+			t.Errorf("String exp <nil>; got %v (%v)", got, err)
+		}
+		if got, err := c.String("null"); err != nil || got != "null" {
+			t.Errorf("String exp null; got %v (%v)", got, err)
+		}
+	})
+	t.Run("Zero", func(t *testing.T) {
+		c := Conv{Empty: EmptyZero}
+		for _, from := range empties {
+			if got, err := c.Int(from); err != nil || got != 0 {
+				t.Errorf("Int(%#v) exp 0; got %v (%v)", from, got, err)
+			}
+			if got, err := c.Uint8(from); err != nil || got != 0 {
+				t.Errorf("Uint8(%#v) exp 0; got %v (%v)", from, got, err)
+			}
+			if got, err := c.Float32(from); err != nil || got != 0 {
+				t.Errorf("Float32(%#v) exp 0; got %v (%v)", from, got, err)
+			}
+			if got, err := c.Bool(from); err != nil || got {
+				t.Errorf("Bool(%#v) exp false; got %v (%v)", from, got, err)
+			}
+			if got, err := c.String(from); err != nil || got != "" {
+				t.Errorf("String(%#v) exp empty; got %q (%v)", from, got, err)
+			}
+			if got, err := c.Bytes(from); err != nil || got != nil {
+				t.Errorf("Bytes(%#v) exp nil; got %v (%v)", from, got, err)
+			}
+			if got, err := c.Complex64(from); err != nil || got != 0 {
+				t.Errorf("Complex64(%#v) exp 0; got %v (%v)", from, got, err)
+			}
+			if got, err := c.Duration(from); err != nil || got != 0 {
+				t.Errorf("Duration(%#v) exp 0; got %v (%v)", from, got, err)
+			}
+			if got, err := c.Time(from); err != nil || !got.IsZero() {
+				t.Errorf("Time(%#v) exp zero; got %v (%v)", from, got, err)
+			}
+			if got, err := c.BigInt(from); err != nil || got != nil {
+				t.Errorf("BigInt(%#v) exp nil; got %v (%v)", from, got, err)
+			}
+			if got, err := c.Decimal(from); err != nil || got.Coef != 0 {
+				t.Errorf("Decimal(%#v) exp 0; got %v (%v)", from, got, err)
+			}
+		}
+		if got, err := c.Int("12"); err != nil || got != 12 {
+			t.Errorf("Int exp 12; got %v (%v)", got, err)
+		}
+		if got, err := c.Int("nullx"); err == nil {
+			t.Errorf("Int exp err; got %v", got)
+		}
+
+		into, bi := 5, *big.NewInt(5)
+		if err := c.Infer(&into, "null"); err != nil || into != 0 {
+			t.Errorf("Infer exp 0; got %v (%v)", into, err)
+		}
+		if err := c.Infer(&bi, nil); err != nil || bi.Sign() != 0 {
+			t.Errorf("Infer exp 0; got %v (%v)", &bi, err)
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		c := Conv{Empty: EmptyError}
+		for _, from := range empties {
+			if got, err := c.String(from); !errors.Is(err, errEmpty) {
+				t.Errorf("String(%#v) exp empty err; got %q (%v)", from, got, err)
+			}
+			if got, err := c.Int64(from); !errors.Is(err, errEmpty) {
+				t.Errorf("Int64(%#v) exp empty err; got %v (%v)", from, got, err)
+			}
+			if got, err := c.Time(from); !errors.Is(err, errEmpty) {
+				t.Errorf("Time(%#v) exp empty err; got %v (%v)", from, got, err)
+			}
+			if got, err := c.Int(from); err == nil {
+				t.Errorf("Int(%#v) exp err; got %v", from, got)
+			}
+		}
+	})
+	t.Run("Keep", func(t *testing.T) {
+		c := Conv{Empty: EmptyKeep}
+		for _, from := range empties {
+			into, when := 5, time.Unix(5, 0)
+			if err := c.Infer(&into, from); err != nil || into != 5 {
+				t.Errorf("Infer(%#v) exp 5; got %v (%v)", from, into, err)
+			}
+			if err := c.Infer(&when, from); err != nil || !when.Equal(time.Unix(5, 0)) {
+				t.Errorf("Infer(%#v) exp unchanged; got %v (%v)", from, when, err)
+			}
+			if got, err := c.Int(from); err != nil || got != 0 {
+				t.Errorf("Int(%#v) exp 0; got %v (%v)", from, got, err)
+			}
+		}
+		into := 5
+		if err := c.Infer(&into, "12"); err != nil || into != 12 {
+			t.Errorf("Infer exp 12; got %v (%v)", into, err)
+		}
+	})
+}
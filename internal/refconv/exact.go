@@ -0,0 +1,92 @@
+package refconv
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// maxExactExp is the largest exponent considered by parseExact, any exponent
+// beyond it is far outside the range of a 64 bit integer.
+const maxExactExp = 1 << 20
+
+var (
+	errNotFinite = errors.New("value is not a finite number")
+	errRange     = errors.New("value out of range")
+)
+
+// parseExact parses the canonical numeric string s into the magnitude and sign
+// of its integer value without any loss of precision, the fractional part is
+// handled by the rounding mode of this Conv. The errors strconv.ErrSyntax and
+// strconv.ErrRange are returned for invalid and out of range numbers.
+func (c *Conv) parseExact(s string) (mag uint64, neg bool, err error) {
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		neg, s = s[0] == '-', s[1:]
+	}
+	if _, ok := parseNonFinite(s); ok {
+		return 0, neg, errNotFinite
+	}
+
+	exp := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		// Atoi saturates exponents which are out of range.
+		exp, err = strconv.Atoi(s[i+1:])
+		if err != nil && !errors.Is(err, strconv.ErrRange) {
+			return 0, neg, strconv.ErrSyntax
+		}
+		if exp > maxExactExp {
+			exp = maxExactExp
+		} else if exp < -maxExactExp {
+			exp = -maxExactExp
+		}
+		s, err = s[:i], nil
+	}
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if len(intPart)+len(fracPart) == 0 || !isDigits(intPart) ||
+		!isDigits(fracPart) {
+		return 0, neg, strconv.ErrSyntax
+	}
+
+	// Shift the decimal point of the significant digits by the exponent to
+	// find the integer and fractional digits.
+	digits := strings.TrimLeft(intPart+fracPart, "0")
+	if len(digits) == 0 {
+		return 0, neg, nil
+	}
+	point := len(intPart) + exp - (len(intPart) + len(fracPart) - len(digits))
+	var frac string
+	switch {
+	case point <= 0:
+		digits, frac = "", digits
+	case point > 20:
+		return 0, neg, strconv.ErrRange
+	case point < len(digits):
+		digits, frac = digits[:point], digits[point:]
+	default:
+		digits += strings.Repeat("0", point-len(digits))
+	}
+
+	for i := 0; i < len(digits); i++ {
+		d := uint64(digits[i] - '0')
+		if mag > (math.MaxUint64-d)/10 {
+			return 0, neg, strconv.ErrRange
+		}
+		mag = mag*10 + d
+	}
+	mag, err = c.roundDigits(mag, neg, frac)
+	return mag, neg, err
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,173 @@
+package refconv
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestParseExact(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		tests := []struct {
+			from string
+			mag  uint64
+			neg  bool
+		}{
+			{"0", 0, false},
+			{"-0", 0, true},
+			{"000", 0, false},
+			{"0.000", 0, false},
+			{"0e99999999999999999999", 0, false},
+			{"12", 12, false},
+			{"+12", 12, false},
+			{"-12", 12, true},
+			{"12.000", 12, false},
+			{"12.", 12, false},
+			{".5", 0, false},
+			{"12.9", 12, false},
+			{"-12.9", 12, true},
+			{"1.5e3", 1500, false},
+			{"1.5E3", 1500, false},
+			{"15e-1", 1, false},
+			{"1e-99999999999999999999", 0, false},
+			{"0.05e3", 50, false},
+			{"00012e2", 1200, false},
+			{"1234e-2", 12, false},
+			{"9223372036854775808", 1 << 63, false},
+			{"18446744073709551615", math.MaxUint64, false},
+			{"18446744073709551615.999", math.MaxUint64, false},
+			{"1.8446744073709551615e19", math.MaxUint64, false},
+			{"0.00000000000000000001e20", 1, false},
+		}
+		var c Conv
+		for _, test := range tests {
+			mag, neg, err := c.parseExact(test.from)
+			if err != nil {
+				t.Errorf("parseExact(%q) unexpected err: %v", test.from, err)
+			} else if mag != test.mag || neg != test.neg {
+				t.Errorf("parseExact(%q) exp %v, %v; got %v, %v",
+					test.from, test.mag, test.neg, mag, neg)
+			}
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			rounding RoundingMode
+			from     string
+			exp      error
+		}{
+			{RoundTruncate, "", strconv.ErrSyntax},
+			{RoundTruncate, ".", strconv.ErrSyntax},
+			{RoundTruncate, "1.2.3", strconv.ErrSyntax},
+			{RoundTruncate, "0x10", strconv.ErrSyntax},
+			{RoundTruncate, "1e", strconv.ErrSyntax},
+			{RoundTruncate, "1ex", strconv.ErrSyntax},
+			{RoundTruncate, "Inf", errNotFinite},
+			{RoundTruncate, "-inf", errNotFinite},
+			{RoundTruncate, "NaN", errNotFinite},
+			{RoundTruncate, "18446744073709551616", strconv.ErrRange},
+			{RoundTruncate, "18446744073709551616.0", strconv.ErrRange},
+			{RoundTruncate, "100000000000000000000", strconv.ErrRange},
+			{RoundTruncate, "1e20", strconv.ErrRange},
+			{RoundTruncate, "1e99999999999999999999", strconv.ErrRange},
+			{RoundError, "12.9", errFraction},
+			{RoundError, "-0.1", errFraction},
+			{RoundError, "15e-1", errFraction},
+		}
+		for _, test := range tests {
+			c := Conv{Rounding: test.rounding}
+			if _, _, err := c.parseExact(test.from); !errors.Is(err, test.exp) {
+				t.Errorf("parseExact(%q) exp err %v; got %v", test.from, test.exp, err)
+			}
+		}
+	})
+	t.Run("Conv", func(t *testing.T) {
+		var c Conv
+		if got, err := c.Int64("9223372036854775807"); err != nil || got != math.MaxInt64 {
+			t.Errorf("Int64 exp MaxInt64; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64("-9223372036854775808"); err != nil || got != math.MinInt64 {
+			t.Errorf("Int64 exp MinInt64; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64("9223372036854775808"); err == nil {
+			t.Errorf("Int64 exp range err; got %v", got)
+		}
+		if got, err := c.Int64("-9223372036854775809"); err == nil {
+			t.Errorf("Int64 exp range err; got %v", got)
+		}
+		if got, err := c.Int64("1.5e3"); err != nil || got != 1500 {
+			t.Errorf("Int64 exp 1500; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64("Inf"); err != nil || got != math.MaxInt64 {
+			t.Errorf("Int64 exp MaxInt64; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64("18446744073709551615.0"); err != nil || got != math.MaxUint64 {
+			t.Errorf("Uint64 exp MaxUint64; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64("18446744073709551616.0"); err == nil {
+			t.Errorf("Uint64 exp range err; got %v", got)
+		}
+		if got, err := c.Uint64("-18446744073709551616"); err != nil || got != 0 {
+			t.Errorf("Uint64 exp 0; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64("NaN"); err != nil || got != 0 {
+			t.Errorf("Uint64 exp 0; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64("1_000"); err != nil || got != 1000 {
+			t.Errorf("Int64 exp 1000; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64("0x1p4"); err != nil || got != 16 {
+			t.Errorf("Int64 exp 16; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64("1_000"); err != nil || got != 1000 {
+			t.Errorf("Uint64 exp 1000; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64("0x1p4"); err != nil || got != 16 {
+			t.Errorf("Uint64 exp 16; got %v (%v)", got, err)
+		}
+
+		c = Conv{Rounding: RoundError}
+		if got, err := c.Int64("12.000"); err != nil || got != 12 {
+			t.Errorf("Int64 exp 12; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64("12.5"); err == nil {
+			t.Errorf("Int64 exp fraction err; got %v", got)
+		}
+		if got, err := c.Uint64("12.5"); err == nil {
+			t.Errorf("Uint64 exp fraction err; got %v", got)
+		}
+	})
+}
+
+func BenchmarkStrToInt64(b *testing.B) {
+	var c Conv
+	var escape int64
+
+	tests := []struct {
+		from interface{}
+		exp  int64
+	}{
+		{"12345", 12345},
+		{"-12345", -12345},
+		{"12.5", 12},
+		{"1.5e3", 1500},
+	}
+	for _, test := range tests {
+		test := test
+		b.Run(test.from.(string), func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				got, err := c.Int64(test.from)
+				if err != nil {
+					b.Fatal(err)
+				}
+				escape = got
+			}
+			if escape != test.exp {
+				b.Fatal(`bad value`)
+			}
+		})
+	}
+}
@@ -8,11 +8,27 @@ import (
 	"github.com/cstockton/go-conv/internal/refutil"
 )
 
-func (c Conv) convStrToFloat64(v string) (float64, bool) {
-	if parsed, perr := strconv.ParseFloat(v, 64); perr == nil {
-		return parsed, true
+func (c *Conv) convStrToFloat64(v string) (float64, bool) {
+	v, err := c.preprocess(v)
+	if err != nil {
+		return 0, false
+	}
+	if norm, ok := c.convNumStr(v); ok {
+		if c.Strict && !isFloatSyntax(norm) && !c.isNonFiniteStr(norm) {
+			return 0, false
+		}
+		if parsed, perr := strconv.ParseFloat(norm, 64); perr == nil {
+			if c.Trace != nil {
+				c.tracef("parsed %q as a float", norm)
+			}
+			return parsed, true
+		}
+	}
+	if c.Strict {
+		return 0, false
 	}
 	if parsed, perr := c.Bool(v); perr == nil {
+		c.tracef("%q is not a number, parsed it as the bool %v", v, parsed)
 		if parsed {
 			return 1, true
 		}
@@ -28,53 +44,96 @@ type floatConverter interface {
 // Float64 attempts to convert the given value to float64, returns the zero
 // value and an error on failure.
 func (c Conv) Float64(from interface{}) (float64, error) {
+	if empty, err := c.convEmpty(from, "float64"); empty {
+		return 0, err
+	}
 	if T, ok := from.(float64); ok {
-		return T, nil
+		return c.convFloat64(from, T)
 	}
-	if c, ok := from.(floatConverter); ok {
-		return c.Float64()
+	if T, ok := from.(floatConverter); ok {
+		c.traceHook(from, "Float64")
+		return T.Float64()
 	}
 
-	value := refutil.IndirectVal(reflect.ValueOf(from))
+	value := c.indirect(from)
 	kind := value.Kind()
 	switch {
 	case reflect.String == kind:
 		if parsed, ok := c.convStrToFloat64(value.String()); ok {
-			return parsed, nil
+			return c.convFloat64(from, parsed)
+		} else if c.Strict {
+			return 0, newConvErrReason(from, "float64", ErrSyntax)
 		}
 	case refutil.IsKindInt(kind):
 		return float64(value.Int()), nil
 	case refutil.IsKindUint(kind):
 		return float64(value.Uint()), nil
 	case refutil.IsKindFloat(kind):
-		return value.Float(), nil
+		return c.convFloat64(from, value.Float())
 	case refutil.IsKindComplex(kind):
-		return real(value.Complex()), nil
+		f, err := c.convComplexToReal(value.Complex())
+		if err != nil {
+			return 0, newConvErrReason(from, "float64", err)
+		}
+		return c.convFloat64(from, f)
 	case reflect.Bool == kind:
+		if c.Strict {
+			return 0, newConvErrReason(from, "float64", ErrUnsupported)
+		}
 		if value.Bool() {
 			return 1, nil
 		}
 		return 0, nil
+	case c.Binary != BinaryNone && isKindBytes(value):
+		f, err := c.convBytesToFloat64(c.convValToBytes(value))
+		if err != nil {
+			return 0, newConvErrReason(from, "float64", err)
+		}
+		return c.convFloat64(from, f)
 	case refutil.IsKindLength(kind):
-		return float64(value.Len()), nil
+		if c.Length == LengthDefault && !c.Strict {
+			c.tracef("converted %v to its length %d", value.Type(), value.Len())
+			return float64(value.Len()), nil
+		}
+		elem, err := c.convUnwrap(value)
+		if err != nil {
+			return 0, newConvErrReason(from, "float64", err)
+		}
+		return c.Float64(elem)
 	}
-	return 0, newConvErr(from, "float64")
+
+	// The math/big types and decimals are checked after the more common kinds
+	// as they are always structs or pointers to them.
+	if r, inf, ok, err := convBigToRat(from); err != nil {
+		return 0, newConvErrReason(from, "float64", err)
+	} else if ok {
+		return c.convRatToFloat64(from, r, inf)
+	}
+	return 0, c.newUnsupportedErr(from, "float64")
 }
 
 // Float32 attempts to convert the given value to Float32, returns the zero
 // value and an error on failure.
 func (c Conv) Float32(from interface{}) (float32, error) {
-	if T, ok := from.(float32); ok {
+	if T, ok := from.(float32); ok && (c.NonFinite == NonFiniteDefault ||
+		!math.IsNaN(float64(T)) && !math.IsInf(float64(T), 0)) {
 		return T, nil
 	}
 
-	if res, err := c.Float64(from); err == nil {
-		if res > math.MaxFloat32 {
-			res = math.MaxFloat32
-		} else if res < -math.MaxFloat32 {
-			res = -math.MaxFloat32
-		}
-		return float32(res), err
+	res, err := c.Float64(from)
+	if err != nil {
+		return 0, newConvErrFrom(from, "float32", err)
+	}
+	if c.isRangeChecked(from) && !math.IsInf(res, 0) && math.Abs(res) > math.MaxFloat32 {
+		return 0, newRangeErr(from, "float32")
+	}
+	return c.convClampFloat32(res), nil
+}
+
+func (c *Conv) convFloat64(from interface{}, f float64) (float64, error) {
+	f, err := c.convNonFinite(f, true)
+	if err != nil {
+		return 0, newConvErrReason(from, "float64", err)
 	}
-	return 0, newConvErr(from, "float32")
+	return f, nil
 }
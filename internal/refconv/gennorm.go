@@ -0,0 +1,298 @@
+//go:build ignore
+
+// Command gennorm writes the Unicode normalization tables in normtables.go
+// from the UnicodeData.txt and CompositionExclusions.txt files of the Unicode
+// Character Database, it is run by go generate. The files are fetched from
+// unicode.org unless -ucd names a local directory holding them.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	version = "14.0.0"
+	header  = "// Code generated by go run gennorm.go from UCD " + version +
+		". DO NOT EDIT.\n\n"
+)
+
+var ucd = flag.String("ucd", "https://www.unicode.org/Public/"+version+"/ucd",
+	"URL or local directory of the Unicode Character Database")
+
+// Hangul syllables are decomposed and composed algorithmically, see section
+// 3.12 of the Unicode Standard.
+const (
+	hangulBase  = 0xAC00
+	hangulCount = 11172
+	jamoLBase   = 0x1100
+	jamoVBase   = 0x1161
+	jamoTBase   = 0x11A7
+	jamoVCount  = 21
+	jamoTCount  = 28
+)
+
+type char struct {
+	ccc    uint8
+	compat bool
+	decomp []rune
+}
+
+func main() {
+	flag.Parse()
+
+	chars, err := parseUnicodeData()
+	if err != nil {
+		log.Fatal(err)
+	}
+	excluded, err := parseExclusions()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	type pair struct{ a, b, r rune }
+	var ccc, canon, compat []string
+	var pairs []pair
+	for _, r := range sortedRunes(chars) {
+		c := chars[r]
+		if c.ccc != 0 {
+			ccc = append(ccc, fmt.Sprintf("0x%X: %d", r, c.ccc))
+		}
+		if len(c.decomp) == 0 {
+			continue
+		}
+
+		nfd := decompose(chars, r, false)
+		nfkd := decompose(chars, r, true)
+		if !equal(nfd, []rune{r}) {
+			canon = append(canon, fmt.Sprintf("0x%X: %v", r, quote(nfd)))
+		}
+		if !equal(nfkd, nfd) {
+			compat = append(compat, fmt.Sprintf("0x%X: %v", r, quote(nfkd)))
+		}
+
+		// Singletons, decompositions which begin with a non-starter and the
+		// listed exclusions are never composed.
+		if !c.compat && len(c.decomp) == 2 && !excluded[r] && c.ccc == 0 &&
+			chars[c.decomp[0]].ccc == 0 {
+			pairs = append(pairs, pair{c.decomp[0], c.decomp[1], r})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		a, b := pairs[i], pairs[j]
+		return a.a < b.a || a.a == b.a && a.b < b.b
+	})
+	comp := make([]string, len(pairs))
+	for i, p := range pairs {
+		comp[i] = fmt.Sprintf("{0x%X, 0x%X}: 0x%X", p.a, p.b, p.r)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("package refconv\n")
+	writeTable(&buf, `combiningClass holds the canonical combining class of each rune which has a
+non-zero class.`, "combiningClass = map[rune]uint8", ccc, 6)
+	writeTable(&buf, `canonicalDecomp holds the full canonical decomposition of each rune which
+has one, excluding Hangul syllables which are decomposed algorithmically.`,
+		"canonicalDecomp = map[rune]string", canon, 3)
+	writeTable(&buf, `compatDecomp holds the full compatibility decomposition of each rune for
+which it differs from the canonical decomposition.`,
+		"compatDecomp = map[rune]string", compat, 3)
+	writeTable(&buf, `composition maps each pair of runes to their primary composite, excluding
+Hangul syllables which are composed algorithmically.`,
+		"composition = map[[2]rune]rune", comp, 3)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("normtables.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeTable(buf *bytes.Buffer, doc, decl string, entries []string, per int) {
+	fmt.Fprintf(buf, "\n// %v\nvar %v{\n", strings.ReplaceAll(doc, "\n", "\n// "), decl)
+	for i := 0; i < len(entries); i += per {
+		end := i + per
+		if end > len(entries) {
+			end = len(entries)
+		}
+		fmt.Fprintf(buf, "\t%v,\n", strings.Join(entries[i:end], ", "))
+	}
+	buf.WriteString("}\n")
+}
+
+// decompose returns the full canonical or compatibility decomposition of r in
+// canonical order.
+func decompose(chars map[rune]char, r rune, compat bool) []rune {
+	res := expand(chars, r, compat, nil)
+	for i := 1; i < len(res); i++ {
+		for j := i; j > 0; j-- {
+			a, b := chars[res[j-1]].ccc, chars[res[j]].ccc
+			if b == 0 || a <= b {
+				break
+			}
+			res[j-1], res[j] = res[j], res[j-1]
+		}
+	}
+	return res
+}
+
+func expand(chars map[rune]char, r rune, compat bool, res []rune) []rune {
+	if s := r - hangulBase; s >= 0 && s < hangulCount {
+		res = append(res, jamoLBase+s/(jamoVCount*jamoTCount),
+			jamoVBase+(s%(jamoVCount*jamoTCount))/jamoTCount)
+		if t := s % jamoTCount; t != 0 {
+			res = append(res, jamoTBase+t)
+		}
+		return res
+	}
+	c := chars[r]
+	if len(c.decomp) == 0 || c.compat && !compat {
+		return append(res, r)
+	}
+	for _, d := range c.decomp {
+		res = expand(chars, d, compat, res)
+	}
+	return res
+}
+
+func parseUnicodeData() (map[rune]char, error) {
+	chars := make(map[rune]char)
+	err := readUCD("UnicodeData.txt", func(fields []string) error {
+		if len(fields) < 6 {
+			return fmt.Errorf("short line: %v", fields)
+		}
+		r, err := parseRune(fields[0])
+		if err != nil {
+			return err
+		}
+		ccc, err := strconv.ParseUint(fields[3], 10, 8)
+		if err != nil {
+			return err
+		}
+
+		c := char{ccc: uint8(ccc)}
+		decomp := strings.Fields(fields[5])
+		if len(decomp) > 0 && strings.HasPrefix(decomp[0], "<") {
+			c.compat, decomp = true, decomp[1:]
+		}
+		for _, d := range decomp {
+			r, err := parseRune(d)
+			if err != nil {
+				return err
+			}
+			c.decomp = append(c.decomp, r)
+		}
+		if c.ccc != 0 || len(c.decomp) > 0 {
+			chars[r] = c
+		}
+		return nil
+	})
+	return chars, err
+}
+
+func parseExclusions() (map[rune]bool, error) {
+	excluded := make(map[rune]bool)
+	err := readUCD("CompositionExclusions.txt", func(fields []string) error {
+		r, err := parseRune(fields[0])
+		excluded[r] = true
+		return err
+	})
+	return excluded, err
+}
+
+// readUCD calls fn with the semicolon separated fields of each line of the
+// named file, comments and blank lines are skipped.
+func readUCD(name string, fn func([]string) error) error {
+	var rc io.ReadCloser
+	if strings.HasPrefix(*ucd, "http://") || strings.HasPrefix(*ucd, "https://") {
+		res, err := http.Get(*ucd + "/" + name)
+		if err != nil {
+			return err
+		}
+		if res.StatusCode != http.StatusOK {
+			res.Body.Close()
+			return fmt.Errorf("%v: %v", name, res.Status)
+		}
+		rc = res.Body
+	} else {
+		f, err := os.Open(filepath.Join(*ucd, name))
+		if err != nil {
+			return err
+		}
+		rc = f
+	}
+	defer rc.Close()
+
+	sc := bufio.NewScanner(rc)
+	for sc.Scan() {
+		line := sc.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if err := fn(fields); err != nil {
+			return fmt.Errorf("%v: %v", name, err)
+		}
+	}
+	return sc.Err()
+}
+
+func parseRune(s string) (rune, error) {
+	r, err := strconv.ParseUint(s, 16, 32)
+	return rune(r), err
+}
+
+func sortedRunes(chars map[rune]char) []rune {
+	runes := make([]rune, 0, len(chars))
+	for r := range chars {
+		runes = append(runes, r)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	return runes
+}
+
+func quote(runes []rune) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range runes {
+		if r <= 0xFFFF {
+			fmt.Fprintf(&b, `\u%04X`, r)
+		} else {
+			fmt.Fprintf(&b, `\U%08X`, r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func equal(a, b []rune) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
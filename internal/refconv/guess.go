@@ -0,0 +1,140 @@
+package refconv
+
+import "strings"
+
+// GuessType is a set of the types which may be returned by Guess, each is
+// tried in the order they are declared.
+type GuessType uint8
+
+// Guess types, the zero value of a set is the same as GuessAll.
+const (
+
+	// GuessInt guesses an int64 from strings such as "42" or "-7".
+	GuessInt GuessType = 1 << iota
+
+	// GuessUint guesses an uint64 from integers beyond the range of int64.
+	GuessUint
+
+	// GuessFloat guesses a float64 from strings such as "1.5" or "1e9".
+	GuessFloat
+
+	// GuessBool guesses a bool from strings such as "true" or "F".
+	GuessBool
+
+	// GuessDuration guesses a time.Duration from strings such as "1h30m".
+	GuessDuration
+
+	// GuessTime guesses a time.Time from strings in any of the formats
+	// supported by Time.
+	GuessTime
+
+	// GuessString returns the string unchanged when no other type was guessed.
+	GuessString
+
+	// GuessAll is the set of every type.
+	GuessAll = GuessInt | GuessUint | GuessFloat | GuessBool | GuessDuration |
+		GuessTime | GuessString
+)
+
+var guessNames = [...]string{
+	"int64", "uint64", "float64", "bool", "time.Duration", "time.Time", "string"}
+
+// String returns the names of the types within the set separated by "|".
+func (t GuessType) String() string {
+	var names []string
+	for i, name := range guessNames {
+		if t&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+func (c *Conv) guessTypes() GuessType {
+	if c.GuessTypes == 0 {
+		return GuessAll
+	}
+	return c.GuessTypes
+}
+
+// guessAs returns s converted to the single type t, only the canonical syntax
+// of each type is accepted with the exception of time.Time.
+func (c *Conv) guessAs(t GuessType, s string) (interface{}, bool) {
+	strict := *c
+	strict.Strict = true
+
+	var v interface{}
+	var err error
+	switch t {
+	case GuessInt:
+		v, err = strict.Int64(s)
+	case GuessUint:
+		v, err = strict.Uint64(s)
+	case GuessFloat:
+		v, err = strict.Float64(s)
+	case GuessBool:
+		v, err = strict.Bool(s)
+	case GuessDuration:
+		v, err = strict.Duration(s)
+	case GuessTime:
+		v, err = c.Time(s)
+	case GuessString:
+		v, err = c.String(s)
+	}
+	return v, err == nil
+}
+
+// Guess returns s converted to the most specific type within the GuessTypes of
+// this Conv, trying each in the order int64, uint64, float64, bool,
+// time.Duration, time.Time and finally string. An error is returned when s
+// does not match any of them, which is only possible if GuessString is not
+// within the set. An empty string is returned as nil regardless of the Empty
+// mode, the same as each empty string within a column given to GuessColumn.
+func (c Conv) Guess(s string) (interface{}, error) {
+	if s == "" {
+		return nil, nil
+	}
+	set := c.guessTypes()
+	for t := GuessInt; t <= GuessString; t <<= 1 {
+		if set&t == 0 {
+			continue
+		}
+		if v, ok := c.guessAs(t, s); ok {
+			return v, nil
+		}
+	}
+	return nil, newConvErrReason(s, set.String(), ErrSyntax)
+}
+
+// GuessColumn returns each string within col converted to the most specific
+// type within the GuessTypes of this Conv which all of them match, tried in the
+// same order as Guess. Empty strings are returned as nil and do not influence
+// the type chosen, for example a column of "1", "" and "2.5" is returned as
+// float64(1), nil and float64(2.5).
+func (c Conv) GuessColumn(col []string) ([]interface{}, error) {
+	set := c.guessTypes()
+	for t := GuessInt; t <= GuessString; t <<= 1 {
+		if set&t == 0 {
+			continue
+		}
+		if res, ok := c.guessColumnAs(t, col); ok {
+			return res, nil
+		}
+	}
+	return nil, newConvErrReason(col, set.String(), ErrSyntax)
+}
+
+func (c *Conv) guessColumnAs(t GuessType, col []string) ([]interface{}, bool) {
+	res := make([]interface{}, len(col))
+	for i, s := range col {
+		if s == "" {
+			continue
+		}
+		v, ok := c.guessAs(t, s)
+		if !ok {
+			return nil, false
+		}
+		res[i] = v
+	}
+	return res, true
+}
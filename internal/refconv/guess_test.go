@@ -0,0 +1,125 @@
+package refconv
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGuess(t *testing.T) {
+	t.Run("Guess", func(t *testing.T) {
+		var c Conv
+		tests := []struct {
+			from string
+			exp  interface{}
+		}{
+			{"42", int64(42)},
+			{"-7", int64(-7)},
+			{"0", int64(0)},
+			{"18446744073709551615", uint64(math.MaxUint64)},
+			{"1.5", 1.5},
+			{"1e3", 1000.0},
+			{"99999999999999999999", 1e20},
+			{"true", true},
+			{"F", false},
+			{"1h30m", 90 * time.Minute},
+			{"2006-01-02T15:04:05Z", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+			{"Mon, 02 Jan 2006 15:04:05 UTC",
+				time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+			{"yes", "yes"},
+			{"3.99 apples", "3.99 apples"},
+			{"inf", "inf"},
+			{"NaN", "NaN"},
+			{"1_000", "1_000"},
+			{"0x1p4", "0x1p4"},
+			{"", nil},
+		}
+		for _, test := range tests {
+			got, err := c.Guess(test.from)
+			if err != nil {
+				t.Errorf("Guess(%q) unexpected err: %v", test.from, err)
+			} else if !reflect.DeepEqual(got, test.exp) {
+				t.Errorf("Guess(%q) exp %#v; got %#v", test.from, test.exp, got)
+			}
+		}
+	})
+	t.Run("GuessTypes", func(t *testing.T) {
+		tests := []struct {
+			set  GuessType
+			from string
+			exp  interface{}
+		}{
+			{GuessFloat | GuessString, "42", 42.0},
+			{GuessBool | GuessString, "1", true},
+			{GuessString, "42", "42"},
+			{GuessDuration | GuessInt, "60s", time.Minute},
+			{GuessAll, "42", int64(42)},
+		}
+		for _, test := range tests {
+			c := Conv{GuessTypes: test.set}
+			got, err := c.Guess(test.from)
+			if err != nil {
+				t.Errorf("Guess(%q) unexpected err: %v", test.from, err)
+			} else if !reflect.DeepEqual(got, test.exp) {
+				t.Errorf("Guess(%q) exp %#v; got %#v", test.from, test.exp, got)
+			}
+		}
+
+		c := Conv{GuessTypes: GuessInt | GuessBool}
+		got, err := c.Guess("1.5")
+		if !errors.Is(err, ErrSyntax) {
+			t.Errorf("Guess exp ErrSyntax; got %v (%v)", got, err)
+		}
+		if exp := "int64|bool"; c.GuessTypes.String() != exp {
+			t.Errorf("String exp %v; got %v", exp, c.GuessTypes)
+		}
+	})
+	t.Run("GuessColumn", func(t *testing.T) {
+		var c Conv
+		tests := []struct {
+			from []string
+			exp  []interface{}
+		}{
+			{[]string{"1", "2"}, []interface{}{int64(1), int64(2)}},
+			{[]string{"1", "", "2.5"}, []interface{}{1.0, nil, 2.5}},
+			{[]string{"1", "18446744073709551615"},
+				[]interface{}{uint64(1), uint64(math.MaxUint64)}},
+			{[]string{"-1", "18446744073709551615"},
+				[]interface{}{-1.0, float64(math.MaxUint64)}},
+			{[]string{"1", "0", "true"}, []interface{}{true, false, true}},
+			{[]string{"1s", "0"}, []interface{}{time.Second, time.Duration(0)}},
+			{[]string{"1", "a"}, []interface{}{"1", "a"}},
+			{[]string{"", ""}, []interface{}{nil, nil}},
+			{[]string{"1.5", "inf"}, []interface{}{"1.5", "inf"}},
+			{[]string{"1", "1_000"}, []interface{}{"1", "1_000"}},
+			{nil, []interface{}{}},
+		}
+		for _, test := range tests {
+			got, err := c.GuessColumn(test.from)
+			if err != nil {
+				t.Errorf("GuessColumn(%q) unexpected err: %v", test.from, err)
+			} else if !reflect.DeepEqual(got, test.exp) {
+				t.Errorf("GuessColumn(%q) exp %#v; got %#v", test.from, test.exp, got)
+			}
+		}
+
+		c = Conv{GuessTypes: GuessInt | GuessFloat}
+		if got, err := c.GuessColumn([]string{"1", "a"}); !errors.Is(err, ErrSyntax) {
+			t.Errorf("GuessColumn exp ErrSyntax; got %v (%v)", got, err)
+		}
+	})
+	t.Run("Empty", func(t *testing.T) {
+		for _, mode := range []EmptyMode{EmptyDefault, EmptyError, EmptyZero, EmptyKeep} {
+			c := Conv{Empty: mode}
+			if got, err := c.Guess(""); err != nil || got != nil {
+				t.Errorf("Guess(%q) with %v exp nil; got %#v (%v)", "", mode, got, err)
+			}
+			got, err := c.GuessColumn([]string{"1", ""})
+			if exp := []interface{}{int64(1), nil}; err != nil || !reflect.DeepEqual(got, exp) {
+				t.Errorf("GuessColumn with %v exp %#v; got %#v (%v)", mode, exp, got, err)
+			}
+		}
+	})
+}
@@ -27,20 +27,24 @@ func (c Conv) Infer(into, from interface{}) error {
 	if !value.CanSet() {
 		return fmt.Errorf(`cannot infer conversion for unchangeable %v (type %[1]T)`, into)
 	}
-
-	v, err := c.infer(value, from)
-	if err != nil {
-		return err
+	if c.Trace != nil {
+		c.tracef("converting %#v (type %[1]T) to %v", from, value.Type())
+	}
+	if c.Empty == EmptyKeep && c.isEmpty(from) {
+		return nil
 	}
 
-	value.Set(reflect.ValueOf(v))
-	return nil
+	return c.inferSet(value, from)
 }
 
-func (c Conv) infer(val reflect.Value, from interface{}) (interface{}, error) {
+func (c *Conv) infer(val reflect.Value, from interface{}) (interface{}, error) {
 	switch val.Kind() {
 	case reflect.Bool:
 		return c.Bool(from)
+	case reflect.Complex64:
+		return c.Complex64(from)
+	case reflect.Complex128:
+		return c.Complex128(from)
 	case reflect.Float32:
 		return c.Float32(from)
 	case reflect.Float64:
@@ -70,12 +74,38 @@ func (c Conv) infer(val reflect.Value, from interface{}) (interface{}, error) {
 			return c.Duration(from)
 		}
 		return c.Int64(from)
+	case reflect.Slice:
+		if val.Type().Elem().Kind() == reflect.Uint8 {
+			return c.Bytes(from)
+		}
+		return c.convSlice(val.Type(), from)
+	case reflect.Map:
+		return c.convMap(val.Type(), from)
+	case reflect.Ptr:
+		switch val.Type().Elem() {
+		case typeOfBigInt:
+			return c.BigInt(from)
+		case typeOfBigFloat:
+			return c.BigFloat(from)
+		case typeOfBigRat:
+			return c.BigRat(from)
+		}
+		return c.convPtr(val.Type(), from)
 	case reflect.Struct:
-		if val.Type() == typeOfTime {
+		switch val.Type() {
+		case typeOfTime:
 			return c.Time(from)
+		case typeOfDecimal:
+			return c.Decimal(from)
+		case typeOfBigInt:
+			return derefBig(c.BigInt(from))
+		case typeOfBigFloat:
+			return derefBig(c.BigFloat(from))
+		case typeOfBigRat:
+			return derefBig(c.BigRat(from))
 		}
 		fallthrough
 	default:
-		return nil, fmt.Errorf(`cannot infer conversion for %v (type %[1]v)`, val)
+		return nil, c.newUnsupportedErr(from, val.Type().String())
 	}
 }
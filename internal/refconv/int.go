@@ -1,7 +1,7 @@
 package refconv
 
 import (
-	"fmt"
+	"errors"
 	"math"
 	"reflect"
 	"strconv"
@@ -34,20 +34,55 @@ func init() {
 	initIntSizes(mathIntSize)
 }
 
-func (c Conv) convStrToInt64(v string) (int64, error) {
-	if parsed, err := strconv.ParseInt(v, 10, 0); err == nil {
-		return parsed, nil
+func (c *Conv) convStrToInt64(v string) (int64, error) {
+	v, err := c.preprocess(v)
+	if err != nil {
+		return 0, newConvErrReason(v, "int64", err)
+	}
+	if norm, ok := c.convNumStr(v); ok {
+		if c.Trace != nil {
+			c.tracef("parsing %q as a number", norm)
+		}
+		if parsed, err := strconv.ParseInt(norm, 10, 64); err == nil {
+			return parsed, nil
+		} else if c.Strict && !isIntSyntax(norm, true) {
+			return 0, newConvErrReason(v, "int64", ErrSyntax)
+		}
+		mag, neg, err := c.parseExact(norm)
+		if err == nil {
+			if neg && mag <= 1<<63 {
+				return int64(-mag), nil
+			} else if !neg && mag <= math.MaxInt64 {
+				return int64(mag), nil
+			}
+			err = strconv.ErrRange
+		}
+		if err == errNotFinite {
+			f, _ := parseNonFinite(norm)
+			return c.convFloatToInt64(v, f)
+		} else if errors.Is(err, strconv.ErrRange) {
+			return 0, newConvErrReason(v, "int64", errRange)
+		} else if err != strconv.ErrSyntax {
+			return 0, newConvErrReason(v, "int64", err)
+		}
+
+		// Forms such as "1_000" and "0x1p4" are not canonical but have always
+		// been accepted by ParseFloat.
+		if parsed, err := strconv.ParseFloat(norm, 64); err == nil {
+			return c.convFloatToInt64(v, parsed)
+		}
 	}
-	if parsed, err := strconv.ParseFloat(v, 64); err == nil {
-		return int64(parsed), nil
+	if c.Strict {
+		return 0, newConvErrReason(v, "int64", ErrSyntax)
 	}
 	if parsed, err := c.convStrToBool(v); err == nil {
+		c.tracef("%q is not a number, parsed it as the bool %v", v, parsed)
 		if parsed {
 			return 1, nil
 		}
 		return 0, nil
 	}
-	return 0, fmt.Errorf("cannot convert %#v (type string) to int64", v)
+	return 0, newConvErr(v, "int64")
 }
 
 type intConverter interface {
@@ -57,16 +92,20 @@ type intConverter interface {
 // Int64 attempts to convert the given value to int64, returns the zero value
 // and an error on failure.
 func (c Conv) Int64(from interface{}) (int64, error) {
+	if empty, err := c.convEmpty(from, "int64"); empty {
+		return 0, err
+	}
 	if T, ok := from.(string); ok {
 		return c.convStrToInt64(T)
 	} else if T, ok := from.(int64); ok {
 		return T, nil
 	}
-	if c, ok := from.(intConverter); ok {
-		return c.Int64()
+	if T, ok := from.(intConverter); ok {
+		c.traceHook(from, "Int64")
+		return T.Int64()
 	}
 
-	value := refutil.IndirectVal(reflect.ValueOf(from))
+	value := c.indirect(from)
 	kind := value.Kind()
 	switch {
 	case reflect.String == kind:
@@ -75,23 +114,55 @@ func (c Conv) Int64(from interface{}) (int64, error) {
 		return value.Int(), nil
 	case refutil.IsKindUint(kind):
 		val := value.Uint()
-		if val > math.MaxInt64 {
+		if val > math.MaxInt64 && c.Strict {
+			return 0, newRangeErr(from, "int64")
+		} else if val > math.MaxInt64 {
+			c.tracef("clamped %v to the range of int64", val)
 			val = math.MaxInt64
 		}
 		return int64(val), nil
 	case refutil.IsKindFloat(kind):
-		return int64(value.Float()), nil
+		return c.convFloatToInt64(from, value.Float())
 	case refutil.IsKindComplex(kind):
-		return int64(real(value.Complex())), nil
+		f, err := c.convComplexToReal(value.Complex())
+		if err != nil {
+			return 0, newConvErrReason(from, "int64", err)
+		}
+		return c.convFloatToInt64(from, f)
 	case reflect.Bool == kind:
+		if c.Strict {
+			return 0, newConvErrReason(from, "int64", ErrUnsupported)
+		}
 		if value.Bool() {
 			return 1, nil
 		}
 		return 0, nil
+	case c.Binary != BinaryNone && isKindBytes(value):
+		v, err := c.convBytesToInt64(c.convValToBytes(value))
+		if err != nil {
+			return 0, newConvErrReason(from, "int64", err)
+		}
+		return v, nil
 	case refutil.IsKindLength(kind):
-		return int64(value.Len()), nil
+		if c.Length == LengthDefault && !c.Strict {
+			c.tracef("converted %v to its length %d", value.Type(), value.Len())
+			return int64(value.Len()), nil
+		}
+		elem, err := c.convUnwrap(value)
+		if err != nil {
+			return 0, newConvErrReason(from, "int64", err)
+		}
+		return c.Int64(elem)
+	}
+
+	// The math/big types and decimals are checked after the more common kinds
+	// as they are always structs or pointers to them.
+	if r, inf, ok, err := convBigToRat(from); err != nil {
+		return 0, newConvErrReason(from, "int64", err)
+	} else if ok {
+		return c.convRatToInt64(from, r, inf)
 	}
-	return 0, newConvErr(from, "int64")
+	return 0, c.newUnsupportedErr(from, "int64")
 }
 
 // Int attempts to convert the given value to int, returns the zero value and an
@@ -103,11 +174,15 @@ func (c Conv) Int(from interface{}) (int, error) {
 
 	to64, err := c.Int64(from)
 	if err != nil {
-		return 0, newConvErr(from, "int")
+		return 0, newConvErrFrom(from, "int", err)
 	}
-	if to64 > mathMaxInt {
+	if (to64 > mathMaxInt || to64 < mathMinInt) && c.isRangeChecked(from) {
+		return 0, newRangeErr(from, "int")
+	} else if to64 > mathMaxInt {
+		c.tracef("clamped %v to the range of int", to64)
 		to64 = mathMaxInt // only possible on 32bit arch
 	} else if to64 < mathMinInt {
+		c.tracef("clamped %v to the range of int", to64)
 		to64 = mathMinInt // only possible on 32bit arch
 	}
 	return int(to64), nil
@@ -122,11 +197,15 @@ func (c Conv) Int8(from interface{}) (int8, error) {
 
 	to64, err := c.Int64(from)
 	if err != nil {
-		return 0, newConvErr(from, "int8")
+		return 0, newConvErrFrom(from, "int8", err)
 	}
-	if to64 > math.MaxInt8 {
+	if (to64 > math.MaxInt8 || to64 < math.MinInt8) && c.isRangeChecked(from) {
+		return 0, newRangeErr(from, "int8")
+	} else if to64 > math.MaxInt8 {
+		c.tracef("clamped %v to the range of int8", to64)
 		to64 = math.MaxInt8
 	} else if to64 < math.MinInt8 {
+		c.tracef("clamped %v to the range of int8", to64)
 		to64 = math.MinInt8
 	}
 	return int8(to64), nil
@@ -141,11 +220,15 @@ func (c Conv) Int16(from interface{}) (int16, error) {
 
 	to64, err := c.Int64(from)
 	if err != nil {
-		return 0, newConvErr(from, "int16")
+		return 0, newConvErrFrom(from, "int16", err)
 	}
-	if to64 > math.MaxInt16 {
+	if (to64 > math.MaxInt16 || to64 < math.MinInt16) && c.isRangeChecked(from) {
+		return 0, newRangeErr(from, "int16")
+	} else if to64 > math.MaxInt16 {
+		c.tracef("clamped %v to the range of int16", to64)
 		to64 = math.MaxInt16
 	} else if to64 < math.MinInt16 {
+		c.tracef("clamped %v to the range of int16", to64)
 		to64 = math.MinInt16
 	}
 	return int16(to64), nil
@@ -160,11 +243,15 @@ func (c Conv) Int32(from interface{}) (int32, error) {
 
 	to64, err := c.Int64(from)
 	if err != nil {
-		return 0, newConvErr(from, "int32")
+		return 0, newConvErrFrom(from, "int32", err)
 	}
-	if to64 > math.MaxInt32 {
+	if (to64 > math.MaxInt32 || to64 < math.MinInt32) && c.isRangeChecked(from) {
+		return 0, newRangeErr(from, "int32")
+	} else if to64 > math.MaxInt32 {
+		c.tracef("clamped %v to the range of int32", to64)
 		to64 = math.MaxInt32
 	} else if to64 < math.MinInt32 {
+		c.tracef("clamped %v to the range of int32", to64)
 		to64 = math.MinInt32
 	}
 	return int32(to64), nil
@@ -0,0 +1,60 @@
+package refconv
+
+import (
+	"errors"
+	"reflect"
+)
+
+// LengthMode controls how arrays, slices, maps and channels are converted to
+// scalar values such as numbers, bools, durations and times.
+type LengthMode uint8
+
+// Length modes, the zero value converts collections to their length.
+const (
+
+	// LengthDefault converts collections to their length for numeric targets
+	// and to whether they are non-empty for bool, other targets return an
+	// error.
+	LengthDefault LengthMode = iota
+
+	// LengthUnwrap converts arrays, slices and maps holding exactly one element
+	// by converting that element, all other collections return an error.
+	LengthUnwrap
+
+	// LengthError returns an error for all collections.
+	LengthError
+)
+
+var (
+	errLength = errors.New("collections are not supported")
+	errUnwrap = errors.New("collection does not hold exactly one element")
+)
+
+// convUnwrap returns the only element of the array, slice or map value when
+// the Length mode of this Conv is LengthUnwrap, otherwise an error.
+func (c *Conv) convUnwrap(value reflect.Value) (interface{}, error) {
+	if c.Length == LengthDefault && c.Strict {
+		return nil, ErrUnsupported
+	} else if c.Length != LengthUnwrap {
+		return nil, errLength
+	}
+
+	var elem reflect.Value
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice:
+		if value.Len() == 1 {
+			elem = value.Index(0)
+		}
+	case reflect.Map:
+		if value.Len() == 1 {
+			iter := value.MapRange()
+			iter.Next()
+			elem = iter.Value()
+		}
+	}
+	if !elem.IsValid() || !elem.CanInterface() {
+		return nil, errUnwrap
+	}
+	c.tracef("unwrapped the only element of %v", value.Type())
+	return elem.Interface(), nil
+}
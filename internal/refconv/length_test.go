@@ -0,0 +1,110 @@
+package refconv
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestLength(t *testing.T) {
+	unwrap := Conv{Length: LengthUnwrap}
+	strict := Conv{Length: LengthError}
+
+	t.Run("Default", func(t *testing.T) {
+		var c Conv
+		if got, err := c.Int([]string{"42"}); err != nil || got != 1 {
+			t.Errorf("Int exp 1; got %v (%v)", got, err)
+		}
+		if got, err := c.Bool([]int{}); err != nil || got {
+			t.Errorf("Bool exp false; got %v (%v)", got, err)
+		}
+		if got, err := c.Duration([]string{"1s"}); err == nil {
+			t.Errorf("Duration exp err; got %v", got)
+		}
+	})
+	t.Run("Unwrap", func(t *testing.T) {
+		ch := make(chan int, 1)
+		ch <- 42
+		if got, err := unwrap.Int([]string{"42"}); err != nil || got != 42 {
+			t.Errorf("Int exp 42; got %v (%v)", got, err)
+		}
+		if got, err := unwrap.Int8([1]string{"300"}); err != nil || got != 127 {
+			t.Errorf("Int8 exp 127; got %v (%v)", got, err)
+		}
+		if got, err := unwrap.Int64([][]string{{"42"}}); err != nil || got != 42 {
+			t.Errorf("Int64 exp 42; got %v (%v)", got, err)
+		}
+		if got, err := unwrap.Uint64(&[]interface{}{uint8(7)}); err != nil || got != 7 {
+			t.Errorf("Uint64 exp 7; got %v (%v)", got, err)
+		}
+		if got, err := unwrap.Float64(map[string]string{"k": "1.5"}); err != nil || got != 1.5 {
+			t.Errorf("Float64 exp 1.5; got %v (%v)", got, err)
+		}
+		if got, err := unwrap.Float32([]float64{1.5}); err != nil || got != 1.5 {
+			t.Errorf("Float32 exp 1.5; got %v (%v)", got, err)
+		}
+		if got, err := unwrap.Bool([]string{"no"}); err != nil || got {
+			t.Errorf("Bool exp false; got %v (%v)", got, err)
+		}
+		if got, err := unwrap.Complex128([]string{"1+2i"}); err != nil || got != 1+2i {
+			t.Errorf("Complex128 exp 1+2i; got %v (%v)", got, err)
+		}
+		if got, err := unwrap.BigInt([]string{"12345678901234567890"}); err != nil ||
+			got.Cmp(new(big.Int).SetUint64(12345678901234567890)) != 0 {
+			t.Errorf("BigInt exp 12345678901234567890; got %v (%v)", got, err)
+		}
+		if got, err := unwrap.Duration([]string{"1s"}); err != nil || got != time.Second {
+			t.Errorf("Duration exp 1s; got %v (%v)", got, err)
+		}
+		exp := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+		if got, err := unwrap.Time([]string{"2020-01-02T00:00:00Z"}); err != nil || !got.Equal(exp) {
+			t.Errorf("Time exp %v; got %v (%v)", exp, got, err)
+		}
+
+		for _, from := range []interface{}{
+			[]string{}, []string{"1", "2"}, map[int]int{}, [0]int{}, ch,
+			[]interface{}{nil}, []string{"foo"},
+		} {
+			if got, err := unwrap.Int64(from); err == nil {
+				t.Errorf("Int64(%v) exp err; got %v", from, got)
+			}
+			if got, err := unwrap.Bool(from); err == nil {
+				t.Errorf("Bool(%v) exp err; got %v", from, got)
+			}
+			if got, err := unwrap.Duration(from); err == nil {
+				t.Errorf("Duration(%v) exp err; got %v", from, got)
+			}
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		for _, from := range []interface{}{
+			[]string{"42"}, [1]int{42}, map[int]int{1: 42}, make(chan int),
+		} {
+			if got, err := strict.Int64(from); err == nil {
+				t.Errorf("Int64(%v) exp err; got %v", from, got)
+			}
+			if got, err := strict.Uint64(from); err == nil {
+				t.Errorf("Uint64(%v) exp err; got %v", from, got)
+			}
+			if got, err := strict.Float64(from); err == nil {
+				t.Errorf("Float64(%v) exp err; got %v", from, got)
+			}
+			if got, err := strict.Bool(from); err == nil {
+				t.Errorf("Bool(%v) exp err; got %v", from, got)
+			}
+			if got, err := strict.Complex128(from); err == nil {
+				t.Errorf("Complex128(%v) exp err; got %v", from, got)
+			}
+			if got, err := strict.BigRat(from); err == nil {
+				t.Errorf("BigRat(%v) exp err; got %v", from, got)
+			}
+		}
+		if got, err := strict.Int64("42"); err != nil || got != 42 {
+			t.Errorf("Int64 exp 42; got %v (%v)", got, err)
+		}
+		c := Conv{Length: LengthError, Binary: BinaryBigEndian}
+		if got, err := c.Int64([]byte{42}); err != nil || got != 42 {
+			t.Errorf("Int64 exp 42; got %v (%v)", got, err)
+		}
+	})
+}
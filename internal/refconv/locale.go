@@ -0,0 +1,202 @@
+package refconv
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Locale describes how numbers are written within strings for a region. The
+// zero value disables locale handling, leaving numeric strings to be parsed by
+// the strconv package as is.
+type Locale struct {
+
+	// Decimal is the decimal separator, i.e. '.' in "1,234.5".
+	Decimal rune
+
+	// Group is the grouping separator, i.e. ',' in "1,234.5". When zero digits
+	// may not be grouped.
+	Group rune
+
+	// Groups contains each additional rune accepted as a grouping separator,
+	// such as the no-break spaces used alongside a space by LocaleFR.
+	Groups string
+
+	// GroupSize is the number of digits within each group, 3 if zero.
+	GroupSize int
+
+	// Minus contains each rune accepted as a minus sign, "-" if empty.
+	Minus string
+}
+
+// Common locales, all of them accept both the hyphen-minus and the unicode
+// minus sign (U+2212).
+var (
+	// LocaleEN writes numbers as "-1,234,567.89".
+	LocaleEN = Locale{Decimal: '.', Group: ',', GroupSize: 3, Minus: "-−"}
+
+	// LocaleDE writes numbers as "-1.234.567,89".
+	LocaleDE = Locale{Decimal: ',', Group: '.', GroupSize: 3, Minus: "-−"}
+
+	// LocaleFR writes numbers as "-1 234 567,89", the no-break space (U+00A0)
+	// and narrow no-break space (U+202F) are also accepted as separators.
+	LocaleFR = Locale{Decimal: ',', Group: ' ', Groups: "\u00a0\u202f",
+		GroupSize: 3, Minus: "-−"}
+
+	// LocaleCH writes numbers as "-1'234'567.89".
+	LocaleCH = Locale{Decimal: '.', Group: '\'', GroupSize: 3, Minus: "-−"}
+)
+
+// IsZero returns true if l is the zero value Locale.
+func (l Locale) IsZero() bool {
+	return l.Decimal == 0 && l.Group == 0 && len(l.Groups) == 0 &&
+		l.GroupSize == 0 && len(l.Minus) == 0
+}
+
+func (l Locale) isMinus(r rune) bool {
+	if len(l.Minus) == 0 {
+		return r == '-'
+	}
+	return strings.ContainsRune(l.Minus, r)
+}
+
+func (l Locale) isGroup(r rune) bool {
+	if l.Group == 0 {
+		return false
+	}
+	return r == l.Group || strings.ContainsRune(l.Groups, r)
+}
+
+// Normalize returns the given numeric string in the canonical form accepted by
+// the strconv package, i.e. "-1.234.567,89" becomes "-1234567.89" for LocaleDE.
+// Groups are validated strictly, so "1,23,4" is rejected for LocaleEN.
+func (l Locale) Normalize(s string) (string, error) {
+	if l.IsZero() {
+		return s, nil
+	}
+
+	size := l.GroupSize
+	if size < 1 {
+		size = 3
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+
+	i := 0
+	if r, n := utf8.DecodeRuneInString(s); l.isMinus(r) {
+		b.WriteByte('-')
+		i += n
+	} else if r == '+' {
+		i += n
+	}
+
+	// Special values are left for strconv to reject or accept.
+	if rest := strings.ToLower(s[i:]); rest == "inf" || rest == "infinity" ||
+		rest == "nan" {
+		b.WriteString(s[i:])
+		return b.String(), nil
+	}
+
+	var (
+		digits, groups int
+		grouped        bool
+	)
+	for i < len(s) {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+			digits++
+			groups++
+		} else if l.isGroup(r) {
+			if groups == 0 || groups > size || (grouped && groups != size) {
+				return "", l.errorf(s, "invalid digit grouping")
+			}
+			grouped, groups = true, 0
+		} else {
+			break
+		}
+		i += n
+	}
+	if grouped && groups != size {
+		return "", l.errorf(s, "invalid digit grouping")
+	}
+
+	if r, n := utf8.DecodeRuneInString(s[i:]); r == l.Decimal {
+		b.WriteByte('.')
+		i += n
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			b.WriteByte(s[i])
+			digits++
+			i++
+		}
+	}
+	if digits == 0 {
+		return "", l.errorf(s, "no digits")
+	}
+
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		b.WriteByte('e')
+		i++
+		if i < len(s) && (s[i] == '-' || s[i] == '+') {
+			b.WriteByte(s[i])
+			i++
+		}
+		start := i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			b.WriteByte(s[i])
+			i++
+		}
+		if start == i {
+			return "", l.errorf(s, "missing exponent")
+		}
+	}
+	if i != len(s) {
+		return "", l.errorf(s, "unexpected characters")
+	}
+	return b.String(), nil
+}
+
+func (l Locale) errorf(s, reason string) error {
+	return fmt.Errorf("cannot parse %#v (type string) as number: %v", s, reason)
+}
+
+// isLocalized returns true if the Locale, Affixes or Suffixes of this Conv may
+// change the meaning of a numeric string.
+func (c *Conv) isLocalized() bool {
+	return !c.Affixes.IsZero() || c.Suffixes != SuffixNone || !c.Locale.IsZero()
+}
+
+// convNumStr returns the canonical form of a numeric string using the Locale,
+// Affixes and Suffixes of this Conv.
+func (c *Conv) convNumStr(v string) (string, bool) {
+	if !c.isLocalized() {
+		return v, true
+	}
+	return c.convLocalizedStr(v)
+}
+
+// convLocalizedStr is convNumStr for a Conv which is localized, it is kept
+// apart so the check for the default options is inlined.
+func (c *Conv) convLocalizedStr(v string) (string, bool) {
+	l := c.Locale
+	if l.IsZero() && (!c.Affixes.IsZero() || c.Suffixes != SuffixNone) {
+		l = Locale{Decimal: '.'}
+	}
+	norm, err := c.Affixes.strip(l, v, func(s string) (string, error) {
+		return c.Suffixes.Scale(l, s)
+	})
+	if err == nil && norm != v {
+		c.tracef("normalized %q to %q", v, norm)
+	}
+	return norm, err == nil
+}
+
+// convDecStr is like convNumStr except strings are always validated as
+// decimal numbers, even when the Locale of this Conv is the zero value.
+func (c Conv) convDecStr(v string) (string, bool) {
+	if c.Locale.IsZero() {
+		c.Locale = Locale{Decimal: '.'}
+	}
+	return c.convNumStr(v)
+}
@@ -0,0 +1,106 @@
+package refconv
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLocale(t *testing.T) {
+	t.Run("Normalize", func(t *testing.T) {
+		tests := []struct {
+			l    Locale
+			from string
+			exp  string
+		}{
+			{Locale{}, "1,234", "1,234"},
+			{LocaleEN, "1,234,567.89", "1234567.89"},
+			{LocaleEN, "-1,234", "-1234"},
+			{LocaleEN, "−1,234", "-1234"},
+			{LocaleEN, "+1,234", "1234"},
+			{LocaleEN, "1234567", "1234567"},
+			{LocaleEN, "123", "123"},
+			{LocaleEN, ".5", ".5"},
+			{LocaleEN, "1,234.5e3", "1234.5e3"},
+			{LocaleEN, "1E-2", "1e-2"},
+			{LocaleEN, "-Inf", "-Inf"},
+			{LocaleEN, "NaN", "NaN"},
+			{LocaleDE, "1.234.567,89", "1234567.89"},
+			{LocaleDE, "-1.234", "-1234"},
+			{LocaleFR, "1 234 567,89", "1234567.89"},
+			{LocaleFR, "1\u00a0234,5", "1234.5"},
+			{LocaleFR, "-1\u202f234\u202f567,89", "-1234567.89"},
+			{Locale{Decimal: '.', Group: ',', Groups: "_"}, "1_234,567", "1234567"},
+			{LocaleCH, "1'234'567.89", "1234567.89"},
+			{Locale{Decimal: '.', Group: ',', GroupSize: 4}, "1,2345", "12345"},
+			{Locale{Decimal: '.', Group: ','}, "1,234", "1234"},
+		}
+		for _, test := range tests {
+			got, err := test.l.Normalize(test.from)
+			if err != nil {
+				t.Errorf("Normalize(%q) unexpected err: %v", test.from, err)
+			} else if got != test.exp {
+				t.Errorf("Normalize(%q) exp %q; got %q", test.from, test.exp, got)
+			}
+		}
+	})
+	t.Run("NormalizeErrors", func(t *testing.T) {
+		tests := []struct {
+			l    Locale
+			from string
+		}{
+			{LocaleEN, ""},
+			{LocaleEN, "-"},
+			{LocaleEN, "1,23,4"},
+			{LocaleEN, "1\u00a0234"},
+			{Locale{Decimal: '.', Groups: "_"}, "1_234"},
+			{LocaleEN, "1,2345"},
+			{LocaleEN, "1234,567"},
+			{LocaleEN, ",123"},
+			{LocaleEN, "1,,234"},
+			{LocaleEN, "1,234,"},
+			{LocaleEN, "1.234,5"},
+			{LocaleEN, "1.2.3"},
+			{LocaleEN, "1e"},
+			{LocaleEN, "1e+"},
+			{LocaleEN, "12a"},
+			{LocaleDE, "1.5"},
+			{LocaleDE, "1.234.56"},
+			{Locale{Decimal: '.'}, "1,234"},
+		}
+		for _, test := range tests {
+			if got, err := test.l.Normalize(test.from); err == nil {
+				t.Errorf("Normalize(%q) exp err; got %q", test.from, got)
+			}
+		}
+	})
+	t.Run("Conv", func(t *testing.T) {
+		c := Conv{Locale: LocaleDE}
+		if got, err := c.Float64("-1.234.567,89"); err != nil || got != -1234567.89 {
+			t.Errorf("Float64 exp -1234567.89; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64("1.234,9"); err != nil || got != 1234 {
+			t.Errorf("Int64 exp 1234; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64("1.234.567"); err != nil || got != 1234567 {
+			t.Errorf("Uint64 exp 1234567; got %v (%v)", got, err)
+		}
+		if got, err := c.Duration("1,5"); err != nil || got != 1500*time.Millisecond {
+			t.Errorf("Duration exp 1.5s; got %v (%v)", got, err)
+		}
+		if got, err := c.Int("true"); err != nil || got != 1 {
+			t.Errorf("Int exp 1; got %v (%v)", got, err)
+		}
+		if got, err := c.Float64("1.5"); err == nil {
+			t.Errorf("Float64 exp err; got %v", got)
+		}
+		if got, err := c.Int64("1,23,4"); err == nil {
+			t.Errorf("Int64 exp err; got %v", got)
+		}
+		if got, err := c.Uint64("1.2.3,4"); err == nil {
+			t.Errorf("Uint64 exp err; got %v", got)
+		}
+		if got, err := c.Duration("1.5"); err == nil {
+			t.Errorf("Duration exp err; got %v", got)
+		}
+	})
+}
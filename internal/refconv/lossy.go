@@ -0,0 +1,313 @@
+package refconv
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/cstockton/go-conv/internal/refutil"
+)
+
+// Exact performs the same conversion as Infer, additionally reporting whether
+// it was lossy, meaning the result does not hold the exact value of from. The
+// value is still assigned to into when the conversion is lossy.
+//
+// Numbers are compared by their exact values, so 1.5 converted to an int, 300
+// clamped to an int8, 0.1 narrowed to a float32 and 1+2i converted to a float64
+// are lossy while "1.50" converted to a float64 is not. Durations converted
+// from seconds are lossy when truncated to whole nanoseconds. Other values are
+// lossy when converting the result back to the type of from does not give an
+// equal value, for example 2 converted to a bool. When the result can not be
+// converted back at all, such as a time.Time converted to a string, only
+// collections and structs are lossy. Strings are instead compared by the
+// result they convert to, so "yes" converted to true is not lossy. The
+// elements of slices and maps are each compared in the same way.
+//
+// Example:
+//
+//   var into int
+//   lossy, err := c.Exact(&into, 1.5)
+//   // into -> 1, lossy -> true
+func (c Conv) Exact(into, from interface{}) (lossy bool, err error) {
+	if err = c.Infer(into, from); err != nil {
+		return false, err
+	}
+	if c.Empty != EmptyDefault && c.isEmpty(from) {
+		return false, nil
+	}
+
+	value, ok := into.(reflect.Value)
+	if !ok {
+		value = reflect.ValueOf(into)
+	}
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	c.Trace = nil
+	return !c.isExact(reflect.ValueOf(from), value), nil
+}
+
+// exactPart is the exact value of one part of a number, a nil r holds a
+// non-finite part within f.
+type exactPart struct {
+	r *big.Rat
+	f float64
+}
+
+func (p exactPart) equal(o exactPart) bool {
+	if p.r == nil || o.r == nil {
+		return p.r == nil && o.r == nil &&
+			(p.f == o.f || math.IsNaN(p.f) && math.IsNaN(o.f))
+	}
+	return p.r.Cmp(o.r) == 0
+}
+
+// exactNum is the exact value of the real and imaginary parts of a number.
+type exactNum struct {
+	re, im exactPart
+}
+
+func (n exactNum) equal(o exactNum) bool {
+	return n.re.equal(o.re) && n.im.equal(o.im)
+}
+
+func exactRat(r *big.Rat) exactNum {
+	return exactNum{re: exactPart{r: r}, im: exactPart{r: new(big.Rat)}}
+}
+
+// exactFloat returns the exact value of f, if shortest is true the value of the
+// shortest decimal which represents f is returned instead.
+func exactFloat(f float64, bits int, shortest bool) exactPart {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return exactPart{f: f}
+	}
+	if shortest {
+		r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, bits))
+		return exactPart{r: r}
+	}
+	return exactPart{r: new(big.Rat).SetFloat64(f)}
+}
+
+// exactValue returns the exact value of the number held by value, floats are
+// given the value of their shortest decimal if shortest is true.
+func exactValue(value reflect.Value, shortest bool) (exactNum, bool) {
+	if value.CanInterface() {
+		if r, inf, ok, err := convBigToRat(value.Interface()); err != nil {
+			return exactNum{}, false
+		} else if ok {
+			if inf != 0 {
+				return exactNum{re: exactPart{f: math.Inf(inf)},
+					im: exactPart{r: new(big.Rat)}}, true
+			}
+			return exactRat(r), true
+		}
+	}
+
+	switch kind := value.Kind(); {
+	case refutil.IsKindInt(kind):
+		return exactRat(new(big.Rat).SetInt64(value.Int())), true
+	case refutil.IsKindUint(kind):
+		return exactRat(new(big.Rat).SetInt(
+			new(big.Int).SetUint64(value.Uint()))), true
+	case refutil.IsKindFloat(kind):
+		return exactNum{re: exactFloat(value.Float(), value.Type().Bits(), shortest),
+			im: exactPart{r: new(big.Rat)}}, true
+	case refutil.IsKindComplex(kind):
+		v, bits := value.Complex(), value.Type().Bits()/2
+		return exactNum{re: exactFloat(real(v), bits, shortest),
+			im: exactFloat(imag(v), bits, shortest)}, true
+	}
+	return exactNum{}, false
+}
+
+// exactSource returns the exact value of the number or numeric string from as
+// it is interpreted when converted to the type to. Decimal is true when from is
+// written in decimal, in which case floats it was converted to are compared by
+// their shortest decimal.
+func (c Conv) exactSource(from reflect.Value, to reflect.Type) (
+	n exactNum, decimal, ok bool) {
+	var secs bool
+	switch kind := from.Kind(); {
+	case reflect.String == kind:
+		n, secs, ok = c.exactString(from.String(), to == typeOfDuration)
+		decimal = true
+	case from.CanInterface() && isBigType(from.Type()):
+		n, ok = exactValue(from, false)
+		decimal = from.Type() != typeOfBigFloat
+	case refutil.IsKindNumeric(kind):
+		secs = to == typeOfDuration &&
+			(refutil.IsKindFloat(kind) || refutil.IsKindComplex(kind))
+		n, ok = exactValue(from, secs)
+	}
+	if ok && secs {
+		n.re, n.im = exactSeconds(n.re), exactSeconds(n.im)
+	}
+	return n, decimal, ok
+}
+
+// exactString returns the exact value of the numeric string s, secs is true if
+// s is a number of seconds when converted to a time.Duration.
+func (c Conv) exactString(s string, dur bool) (n exactNum, secs, ok bool) {
+	v, err := c.preprocess(s)
+	if err != nil {
+		return exactNum{}, false, false
+	}
+	if dur {
+		if d, err := time.ParseDuration(v); err == nil {
+			return exactRat(new(big.Rat).SetInt64(int64(d))), false, true
+		}
+	}
+	norm, ok := c.convDecStr(v)
+	if !ok {
+		return exactNum{}, false, false
+	}
+	if r, err := convStrToRat(norm); err == nil {
+		return exactRat(r), dur && !isIntSyntax(norm, true), true
+	}
+	if f, err := strconv.ParseFloat(norm, 64); err == nil {
+		return exactNum{re: exactPart{f: f}, im: exactPart{r: new(big.Rat)}},
+			false, true
+	}
+	if v, err := strconv.ParseComplex(norm, 128); err == nil {
+		return exactNum{re: exactFloat(real(v), 64, true),
+			im: exactFloat(imag(v), 64, true)}, dur, true
+	}
+	return exactNum{}, false, false
+}
+
+// exactSeconds returns the number of nanoseconds within the seconds p.
+func exactSeconds(p exactPart) exactPart {
+	if p.r == nil {
+		return p
+	}
+	return exactPart{r: new(big.Rat).Mul(p.r, big.NewRat(int64(time.Second), 1))}
+}
+
+// isExact returns true if result holds the exact value of from.
+func (c Conv) isExact(from, result reflect.Value) bool {
+	from, result = exactIndirect(from), exactIndirect(result)
+	if isNilValue(from) {
+		return true
+	} else if isNilValue(result) {
+		return false
+	}
+
+	switch {
+	case isKindList(from.Kind()) && isKindList(result.Kind()),
+		from.Kind() == reflect.Map && result.Kind() == reflect.Map:
+		return c.isExactElems(from, result)
+	}
+	switch result.Kind() {
+	case reflect.Bool, reflect.String:
+	default:
+		if want, decimal, ok := c.exactSource(from, result.Type()); ok {
+			got, ok := exactValue(result, decimal)
+			if ok {
+				return want.equal(got)
+			}
+		}
+	}
+	return c.isExactRoundTrip(from, result)
+}
+
+// isExactElems returns true if each element of the array, slice or map result
+// holds the exact value of the element of from it was converted from.
+func (c Conv) isExactElems(from, result reflect.Value) bool {
+	if from.Len() != result.Len() {
+		return false
+	}
+	if from.Kind() != reflect.Map {
+		for i := 0; i < from.Len(); i++ {
+			if !c.isExact(from.Index(i), result.Index(i)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	iter := from.MapRange()
+	for iter.Next() {
+		if !iter.Key().CanInterface() {
+			return false
+		}
+		key := reflect.New(result.Type().Key()).Elem()
+		if err := c.inferSet(key, iter.Key().Interface()); err != nil {
+			return false
+		}
+		elem := result.MapIndex(key)
+		if !elem.IsValid() || !c.isExact(iter.Key(), key) ||
+			!c.isExact(iter.Value(), elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// isExactRoundTrip returns true if converting result back to the type of from
+// gives a value equal to from. When from is a string the result it converts to
+// is compared instead, strings parsed as a time.Time are always exact as they
+// keep each field their layout holds. When result can not be converted back
+// the value is exact unless from has parts which may have been lost, see
+// hasParts.
+func (c Conv) isExactRoundTrip(from, result reflect.Value) bool {
+	if !from.CanInterface() || !result.CanInterface() {
+		return false
+	}
+	if from.Kind() == reflect.String && result.Type() == typeOfTime {
+		return true
+	}
+
+	back := reflect.New(from.Type())
+	if err := c.Infer(back, result.Interface()); err != nil {
+		return !hasParts(from)
+	}
+	if from.Kind() != reflect.String {
+		return reflect.DeepEqual(from.Interface(), back.Elem().Interface())
+	}
+
+	again := reflect.New(result.Type())
+	if err := c.Infer(again, back.Elem().Interface()); err != nil {
+		return true
+	}
+	return reflect.DeepEqual(result.Interface(), again.Elem().Interface())
+}
+
+// hasParts returns true if value is a collection or struct, which are lossy
+// when converted to a value that can not be converted back, such as their
+// length or formatted string. Other values and the structs converted like
+// numbers tell nothing about what was lost in that case.
+func hasParts(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Map:
+		return true
+	case reflect.Struct:
+		return value.Type() != typeOfTime && !isBig(value.Interface())
+	}
+	return false
+}
+
+// exactIndirect returns the value held by any interfaces or pointers within
+// value.
+func exactIndirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	return refutil.IndirectVal(value)
+}
+
+// isNilValue returns true if value is invalid or a nil interface or pointer.
+func isNilValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Interface, reflect.Ptr:
+		return value.IsNil()
+	}
+	return false
+}
+
+func isKindList(k reflect.Kind) bool {
+	return k == reflect.Array || k == reflect.Slice
+}
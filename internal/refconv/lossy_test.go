@@ -0,0 +1,129 @@
+package refconv
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cstockton/go-conv/decimal"
+)
+
+func TestExact(t *testing.T) {
+	var c Conv
+	tests := []struct {
+		into  interface{}
+		from  interface{}
+		lossy bool
+	}{
+		{new(int), 1, false},
+		{new(int), 1.0, false},
+		{new(int), 1.5, true},
+		{new(int), "1.50", true},
+		{new(int), "12.000", false},
+		{new(int8), 127, false},
+		{new(int8), 300, true},
+		{new(uint), -1, true},
+		{new(int64), uint64(math.MaxUint64), true},
+		{new(float64), float32(0.1), false},
+		{new(float32), 0.1, true},
+		{new(float32), 0.5, false},
+		{new(float32), 1e300, true},
+		{new(float64), "0.1", false},
+		{new(float64), "1.50", false},
+		{new(float32), "0.123456789", true},
+		{new(float64), int64(1<<53 + 1), true},
+		{new(float64), math.NaN(), false},
+		{new(int), math.NaN(), true},
+		{new(float64), complex(1.5, 0), false},
+		{new(float64), complex(1.5, 2), true},
+		{new(complex64), complex(0.1, 0.5), true},
+		{new(complex64), complex(0.5, 0.5), false},
+		{new(float64), decimal.Decimal{Coef: 1, Scale: 1}, false},
+		{new(float64), big.NewRat(1, 3), true},
+		{new(int), big.NewInt(42), false},
+		{new(time.Duration), 1.5, false},
+		{new(time.Duration), 1.5e-10, true},
+		{new(time.Duration), "1.5", false},
+		{new(time.Duration), "15", false},
+		{new(time.Duration), "1m30s", false},
+		{new(time.Duration), int64(90), false},
+		{new(float64), time.Second, false},
+		{new(bool), 1, false},
+		{new(bool), 2, true},
+		{new(bool), "yes", false},
+		{new(int), true, false},
+		{new(string), 1.5, false},
+		{new(string), float32(0.1), false},
+		{new(string), "foo", false},
+		{new(time.Time), "2006-01-02T15:04:05Z", false},
+		{new(string), time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), false},
+		{new(int), []int{1, 2}, true},
+		{new([]int), []string{"1", "2"}, false},
+		{new([]int), []string{"1", "2.5"}, true},
+		{new([]int), []interface{}{1, 2.0}, false},
+		{new(map[int]float32), map[string]float64{"1": 0.5}, false},
+		{new(map[int]float32), map[string]float64{"1": 0.1}, true},
+		{new(map[int]int), map[string]int{"1": 1, "01": 2}, true},
+	}
+	for _, test := range tests {
+		lossy, err := c.Exact(test.into, test.from)
+		if err != nil {
+			t.Errorf("Exact(%T, %#v) exp nil err; got %v", test.into, test.from, err)
+		} else if lossy != test.lossy {
+			t.Errorf("Exact(%T, %#v) exp lossy %v; got %v",
+				test.into, test.from, test.lossy, lossy)
+		}
+	}
+
+	t.Run("Result", func(t *testing.T) {
+		var into int8
+		lossy, err := c.Exact(&into, "300")
+		if err != nil || !lossy || into != 127 {
+			t.Errorf("Exact exp 127 and lossy; got %v, %v (%v)", into, lossy, err)
+		}
+		if _, err := c.Exact(&into, "abc"); err == nil {
+			t.Error("Exact exp non-nil err")
+		}
+
+		val := reflect.New(reflect.TypeOf(0)).Elem()
+		if lossy, err := c.Exact(val, 2.5); err != nil || !lossy || val.Int() != 2 {
+			t.Errorf("Exact exp 2 and lossy; got %v, %v (%v)", val, lossy, err)
+		}
+	})
+	t.Run("Options", func(t *testing.T) {
+		var steps int
+		c := Conv{
+			Empty:     EmptyZero,
+			Locale:    LocaleDE,
+			Rounding:  RoundHalfUp,
+			NonFinite: NonFiniteSaturate,
+			Trace:     func(string) { steps++ },
+		}
+		tests := []struct {
+			into  interface{}
+			from  interface{}
+			lossy bool
+		}{
+			{new(int), nil, false},
+			{new(int), "null", false},
+			{new(float64), "1.234,5", false},
+			{new(int), "1.234,5", true},
+			{new(int), 2.5, true},
+			{new(float64), math.Inf(1), true},
+		}
+		for _, test := range tests {
+			lossy, err := c.Exact(test.into, test.from)
+			if err != nil {
+				t.Errorf("Exact(%T, %#v) exp nil err; got %v", test.into, test.from, err)
+			} else if lossy != test.lossy {
+				t.Errorf("Exact(%T, %#v) exp lossy %v; got %v",
+					test.into, test.from, test.lossy, lossy)
+			}
+		}
+		if steps == 0 {
+			t.Error("Exact exp Trace to be called")
+		}
+	})
+}
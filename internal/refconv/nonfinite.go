@@ -0,0 +1,123 @@
+package refconv
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// NonFiniteMode controls how NaN and infinite values are converted.
+type NonFiniteMode uint8
+
+// Non-finite modes, the zero value keeps the behavior of each target type.
+const (
+
+	// NonFiniteDefault converts NaN to false for bool, zero for integers and
+	// durations and is kept for floats. Infinity is true for bool, saturates
+	// integers and float32, is zero for durations and is kept for float64.
+	NonFiniteDefault NonFiniteMode = iota
+
+	// NonFiniteError returns an error for NaN and infinite values.
+	NonFiniteError
+
+	// NonFiniteZero converts NaN and infinite values to the zero value.
+	NonFiniteZero
+
+	// NonFiniteSaturate converts infinite values to the maximum or minimum
+	// value of the target type and NaN to the zero value. Infinite values
+	// return an error for the math/big and decimal targets, which are
+	// unbounded.
+	NonFiniteSaturate
+
+	// NonFinitePass keeps NaN and infinite values for float targets, all other
+	// targets return an error.
+	NonFinitePass
+)
+
+// convNonFinite applies the NonFinite mode of this Conv to f if it is NaN or
+// infinite, float is true when the target of conversion is a float. Saturated
+// values are returned as +/-math.MaxFloat64 which the caller clamps to the
+// range of its target type. When the mode is NonFiniteDefault f is returned
+// unchanged so the caller may apply the behavior of its target.
+func (c *Conv) convNonFinite(f float64, float bool) (float64, error) {
+	if !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return f, nil
+	}
+	switch c.NonFinite {
+	case NonFiniteError:
+		return 0, errNotFinite
+	case NonFiniteZero:
+		c.tracef("converted %v to 0", f)
+		return 0, nil
+	case NonFiniteSaturate:
+		if math.IsNaN(f) {
+			c.tracef("converted %v to 0", f)
+			return 0, nil
+		}
+		c.tracef("saturated %v to %v", f, math.Copysign(math.MaxFloat64, f))
+		return math.Copysign(math.MaxFloat64, f), nil
+	case NonFinitePass:
+		if !float {
+			return 0, errNotFinite
+		}
+	}
+	return f, nil
+}
+
+// convNonFiniteBig is like convNonFinite for the math/big and decimal targets,
+// which have no maximum to saturate to. Only a *big.Float, for which float is
+// true, may hold an infinite value and none of them may hold NaN.
+func (c *Conv) convNonFiniteBig(f float64, float bool) (float64, error) {
+	if math.IsInf(f, 0) && c.NonFinite == NonFiniteSaturate {
+		return 0, errNotFinite
+	}
+	f, err := c.convNonFinite(f, float)
+	if err == nil && (math.IsNaN(f) || !float && math.IsInf(f, 0)) {
+		err = errNotFinite
+	}
+	return f, err
+}
+
+// parseNonFinite returns the value of s if it is NaN or an infinity with an
+// optional sign, ignoring case.
+func parseNonFinite(s string) (float64, bool) {
+	var neg bool
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		neg, s = s[0] == '-', s[1:]
+	}
+	switch {
+	case strings.EqualFold(s, "nan"):
+		return math.NaN(), true
+	case strings.EqualFold(s, "inf"), strings.EqualFold(s, "infinity"):
+		if neg {
+			return math.Inf(-1), true
+		}
+		return math.Inf(1), true
+	}
+	return 0, false
+}
+
+// isNonFiniteStr returns true if s is NaN or an infinity and the NonFinite mode
+// of this Conv is set, strict conversions only accept such strings when it is.
+func (c *Conv) isNonFiniteStr(s string) bool {
+	if c.NonFinite == NonFiniteDefault {
+		return false
+	}
+	_, ok := parseNonFinite(s)
+	return ok
+}
+
+// convSecsToDuration converts the given seconds to a time.Duration, values
+// beyond the range of a time.Duration are clamped.
+func convSecsToDuration(secs float64) time.Duration {
+	switch ns := secs * 1e9; {
+	case math.IsNaN(ns):
+		return 0
+	case ns >= math.MaxInt64:
+		return math.MaxInt64
+	case ns <= math.MinInt64:
+		return math.MinInt64
+	default:
+		return time.Duration(ns)
+	}
+}
@@ -0,0 +1,125 @@
+package refconv
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNonFinite(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+
+	type result struct {
+		val interface{}
+		err bool
+	}
+	ok := func(v interface{}) result { return result{val: v} }
+	fail := result{err: true}
+
+	isNaN := func(v interface{}) bool {
+		switch T := v.(type) {
+		case float32:
+			return T != T
+		case float64:
+			return T != T
+		}
+		return false
+	}
+
+	targets := []struct {
+		name string
+		fn   func(c Conv, from interface{}) (interface{}, error)
+	}{
+		{"Bool", func(c Conv, from interface{}) (interface{}, error) { return c.Bool(from) }},
+		{"Int64", func(c Conv, from interface{}) (interface{}, error) { return c.Int64(from) }},
+		{"Uint64", func(c Conv, from interface{}) (interface{}, error) { return c.Uint64(from) }},
+		{"Float32", func(c Conv, from interface{}) (interface{}, error) { return c.Float32(from) }},
+		{"Float64", func(c Conv, from interface{}) (interface{}, error) { return c.Float64(from) }},
+		{"Duration", func(c Conv, from interface{}) (interface{}, error) { return c.Duration(from) }},
+	}
+
+	tests := []struct {
+		mode NonFiniteMode
+		from interface{}
+		exp  []result // in order of targets
+	}{
+		{NonFiniteDefault, nan, []result{ok(false), ok(int64(0)), ok(uint64(0)),
+			ok(float32(nan)), ok(nan), ok(time.Duration(0))}},
+		{NonFiniteDefault, inf, []result{ok(true), ok(int64(math.MaxInt64)),
+			ok(uint64(math.MaxUint64)), ok(float32(math.MaxFloat32)), ok(inf),
+			ok(time.Duration(0))}},
+		{NonFiniteDefault, -inf, []result{ok(true), ok(int64(math.MinInt64)),
+			ok(uint64(0)), ok(float32(-math.MaxFloat32)), ok(-inf),
+			ok(time.Duration(0))}},
+		{NonFiniteDefault, "NaN", []result{ok(false), ok(int64(0)), ok(uint64(0)),
+			ok(float32(nan)), ok(nan), ok(time.Duration(0))}},
+		{NonFiniteDefault, "Inf", []result{ok(true), ok(int64(math.MaxInt64)),
+			ok(uint64(math.MaxUint64)), ok(float32(math.MaxFloat32)), ok(inf),
+			ok(time.Duration(0))}},
+		{NonFiniteDefault, "-infinity", []result{ok(true), ok(int64(math.MinInt64)),
+			ok(uint64(0)), ok(float32(-math.MaxFloat32)), ok(-inf),
+			ok(time.Duration(0))}},
+		{NonFiniteError, nan, []result{fail, fail, fail, fail, fail, fail}},
+		{NonFiniteError, -inf, []result{fail, fail, fail, fail, fail, fail}},
+		{NonFiniteError, "NaN", []result{fail, fail, fail, fail, fail, fail}},
+		{NonFiniteError, complex(nan, 0), []result{fail, fail, fail, fail, fail, fail}},
+		{NonFiniteError, float32(inf), []result{fail, fail, fail, fail, fail, fail}},
+		{NonFiniteZero, nan, []result{ok(false), ok(int64(0)), ok(uint64(0)),
+			ok(float32(0)), ok(float64(0)), ok(time.Duration(0))}},
+		{NonFiniteZero, "-Inf", []result{ok(false), ok(int64(0)), ok(uint64(0)),
+			ok(float32(0)), ok(float64(0)), ok(time.Duration(0))}},
+		{NonFiniteSaturate, inf, []result{ok(true), ok(int64(math.MaxInt64)),
+			ok(uint64(math.MaxUint64)), ok(float32(math.MaxFloat32)),
+			ok(float64(math.MaxFloat64)), ok(time.Duration(math.MaxInt64))}},
+		{NonFiniteSaturate, "-Inf", []result{ok(true), ok(int64(math.MinInt64)),
+			ok(uint64(0)), ok(float32(-math.MaxFloat32)),
+			ok(float64(-math.MaxFloat64)), ok(time.Duration(math.MinInt64))}},
+		{NonFiniteSaturate, "NaN", []result{ok(false), ok(int64(0)), ok(uint64(0)),
+			ok(float32(0)), ok(float64(0)), ok(time.Duration(0))}},
+		{NonFiniteSaturate, nan, []result{ok(false), ok(int64(0)), ok(uint64(0)),
+			ok(float32(0)), ok(float64(0)), ok(time.Duration(0))}},
+		{NonFinitePass, inf, []result{fail, fail, fail, ok(float32(inf)), ok(inf),
+			fail}},
+		{NonFinitePass, "-Inf", []result{fail, fail, fail, ok(float32(-inf)),
+			ok(-inf), fail}},
+		{NonFinitePass, float32(nan), []result{fail, fail, fail, ok(float32(nan)),
+			ok(nan), fail}},
+		{NonFinitePass, 1.5, []result{ok(true), ok(int64(1)), ok(uint64(1)),
+			ok(float32(1.5)), ok(1.5), ok(1500 * time.Millisecond)}},
+	}
+	for _, test := range tests {
+		c := Conv{NonFinite: test.mode}
+		for i, target := range targets {
+			exp := test.exp[i]
+			got, err := target.fn(c, test.from)
+			if exp.err {
+				if err == nil {
+					t.Errorf("mode %v: %v(%v) exp err; got %v",
+						test.mode, target.name, test.from, got)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("mode %v: %v(%v) unexpected err: %v",
+					test.mode, target.name, test.from, err)
+			} else if isNaN(exp.val) && isNaN(got) {
+				continue
+			} else if got != exp.val {
+				t.Errorf("mode %v: %v(%v) exp %v; got %v",
+					test.mode, target.name, test.from, exp.val, got)
+			}
+		}
+	}
+
+	t.Run("convSecsToDuration", func(t *testing.T) {
+		if got := convSecsToDuration(1e300); got != math.MaxInt64 {
+			t.Errorf("exp MaxInt64; got %v", got)
+		}
+		if got := convSecsToDuration(-1e300); got != math.MinInt64 {
+			t.Errorf("exp MinInt64; got %v", got)
+		}
+		if got := convSecsToDuration(nan); got != 0 {
+			t.Errorf("exp 0; got %v", got)
+		}
+	})
+}
@@ -0,0 +1,221 @@
+package refconv
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+//go:generate go run gennorm.go
+
+// UTF8Mode controls how invalid UTF-8 within bytes is handled when they are
+// converted to strings.
+type UTF8Mode uint8
+
+// UTF-8 modes, the zero value casts byte slices as is and formats byte arrays
+// as a list of numbers.
+const (
+
+	// UTF8Default casts byte slices to strings as is, byte arrays are formatted
+	// by the fmt package.
+	UTF8Default UTF8Mode = iota
+
+	// UTF8Pass casts byte slices and arrays to strings as is.
+	UTF8Pass
+
+	// UTF8Replace replaces each byte of an invalid UTF-8 sequence within byte
+	// slices and arrays with the Unicode replacement character U+FFFD.
+	UTF8Replace
+
+	// UTF8Error returns an error for byte slices and arrays which are not
+	// valid UTF-8.
+	UTF8Error
+)
+
+var errInvalidUTF8 = errors.New("invalid UTF-8")
+
+// Valid returns s with invalid UTF-8 handled according to this mode, an error
+// is returned which reports the offset of the first invalid byte for
+// UTF8Error.
+func (m UTF8Mode) Valid(s string) (string, error) {
+	if m < UTF8Replace || utf8.ValidString(s) {
+		return s, nil
+	}
+	if m == UTF8Error {
+		for i, r := range s {
+			if r == utf8.RuneError {
+				if _, n := utf8.DecodeRuneInString(s[i:]); n == 1 {
+					return "", fmt.Errorf("%w at byte %d", errInvalidUTF8, i)
+				}
+			}
+		}
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		b.WriteRune(r)
+	}
+	return b.String(), nil
+}
+
+// NormForm selects the Unicode normalization form applied to strings.
+type NormForm uint8
+
+// Normalization forms, the zero value leaves strings unchanged.
+const (
+
+	// NormNone leaves strings unchanged.
+	NormNone NormForm = iota
+
+	// NormNFC applies canonical decomposition followed by canonical
+	// composition, so "e\u0301" becomes "\u00e9".
+	NormNFC
+
+	// NormNFKC applies compatibility decomposition followed by canonical
+	// composition, so "\ufb01" becomes "fi" and "\u00b2" becomes "2".
+	NormNFKC
+)
+
+// Hangul syllables are decomposed and composed algorithmically, see section
+// 3.12 of the Unicode Standard.
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// Normalize returns s in this normalization form. Invalid UTF-8 is kept as is
+// and treated as a starter. The tables are generated from version 14.0.0 of
+// the Unicode Character Database.
+func (f NormForm) Normalize(s string) string {
+	if f == NormNone || isASCII(s) {
+		return s
+	}
+	return compose(decompose(s, f == NormNFKC))
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// normRune is a decoded rune which remembers the bytes it was decoded from so
+// invalid UTF-8 may be written back unchanged.
+type normRune struct {
+	r   rune
+	raw string
+}
+
+func (n normRune) ccc() uint8 {
+	if n.raw != "" {
+		return 0
+	}
+	return combiningClass[n.r]
+}
+
+// decompose returns the full decomposition of s in canonical order.
+func decompose(s string, compat bool) []normRune {
+	out := make([]normRune, 0, len(s))
+	for i, r := range s {
+		if r == utf8.RuneError {
+			if _, n := utf8.DecodeRuneInString(s[i:]); n == 1 {
+				out = append(out, normRune{r: r, raw: s[i : i+1]})
+				continue
+			}
+		}
+		if si := r - hangulSBase; si >= 0 && si < hangulSCount {
+			out = append(out,
+				normRune{r: hangulLBase + si/hangulNCount},
+				normRune{r: hangulVBase + (si%hangulNCount)/hangulTCount})
+			if ti := si % hangulTCount; ti != 0 {
+				out = append(out, normRune{r: hangulTBase + ti})
+			}
+			continue
+		}
+		d, ok := "", false
+		if compat {
+			d, ok = compatDecomp[r]
+		}
+		if !ok {
+			d, ok = canonicalDecomp[r]
+		}
+		if !ok {
+			out = append(out, normRune{r: r})
+			continue
+		}
+		for _, dr := range d {
+			out = append(out, normRune{r: dr})
+		}
+	}
+
+	// Canonical ordering sorts each run of non-starters by combining class,
+	// insertion sort is stable and runs are short.
+	for i := 1; i < len(out); i++ {
+		c := out[i].ccc()
+		if c == 0 {
+			continue
+		}
+		for j := i; j > 0 && out[j-1].ccc() > c; j-- {
+			out[j-1], out[j] = out[j], out[j-1]
+		}
+	}
+	return out
+}
+
+// compose applies canonical composition to the decomposed runes.
+func compose(rs []normRune) string {
+	starter, last := -1, uint8(0)
+	n := 0
+	for i := 0; i < len(rs); i++ {
+		cur, c := rs[i], rs[i].ccc()
+		blocked := last >= c && (last != 0 || n-1 != starter)
+		if starter >= 0 && !blocked && rs[starter].raw == "" && cur.raw == "" {
+			if p, ok := composePair(rs[starter].r, cur.r); ok {
+				rs[starter].r = p
+				continue
+			}
+		}
+		if c == 0 {
+			starter = n
+		}
+		last = c
+		rs[n] = cur
+		n++
+	}
+
+	var b strings.Builder
+	b.Grow(n * 3)
+	for _, nr := range rs[:n] {
+		if nr.raw != "" {
+			b.WriteString(nr.raw)
+		} else {
+			b.WriteRune(nr.r)
+		}
+	}
+	return b.String()
+}
+
+func composePair(a, b rune) (rune, bool) {
+	if li, vi := a-hangulLBase, b-hangulVBase; li >= 0 && li < hangulLCount &&
+		vi >= 0 && vi < hangulVCount {
+		return hangulSBase + (li*hangulVCount+vi)*hangulTCount, true
+	}
+	if si, ti := a-hangulSBase, b-hangulTBase; si >= 0 && si < hangulSCount &&
+		si%hangulTCount == 0 && ti > 0 && ti < hangulTCount {
+		return a + ti, true
+	}
+	r, ok := composition[[2]rune{a, b}]
+	return r, ok
+}
@@ -0,0 +1,140 @@
+package refconv
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUTF8(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		tests := []struct {
+			m    UTF8Mode
+			from string
+			exp  string
+		}{
+			{UTF8Default, "hi\xff", "hi\xff"},
+			{UTF8Pass, "hi\xff", "hi\xff"},
+			{UTF8Replace, "hi", "hi"},
+			{UTF8Replace, "日本", "日本"},
+			{UTF8Replace, "hi\xff", "hi\ufffd"},
+			{UTF8Replace, "\xe6\x97hi", "\ufffd\ufffdhi"},
+			{UTF8Replace, "\xed\xa0\x80", "\ufffd\ufffd\ufffd"},
+			{UTF8Error, "日本", "日本"},
+		}
+		for _, test := range tests {
+			got, err := test.m.Valid(test.from)
+			if err != nil {
+				t.Errorf("Valid(%q) unexpected err: %v", test.from, err)
+			} else if got != test.exp {
+				t.Errorf("Valid(%q) exp %q; got %q", test.from, test.exp, got)
+			}
+		}
+	})
+	t.Run("ValidErrors", func(t *testing.T) {
+		tests := []struct {
+			from string
+			exp  string
+		}{
+			{"\xff", "invalid UTF-8 at byte 0"},
+			{"hi\xff", "invalid UTF-8 at byte 2"},
+			{"日本\xe6\x97", "invalid UTF-8 at byte 6"},
+		}
+		for _, test := range tests {
+			_, err := UTF8Error.Valid(test.from)
+			if !errors.Is(err, errInvalidUTF8) || err.Error() != test.exp {
+				t.Errorf("Valid(%q) exp err %q; got %v", test.from, test.exp, err)
+			}
+		}
+	})
+	t.Run("String", func(t *testing.T) {
+		invalid := []byte("hi\xff")
+		tests := []struct {
+			m    UTF8Mode
+			from interface{}
+			exp  string
+		}{
+			{UTF8Default, invalid, "hi\xff"},
+			{UTF8Default, [2]byte{'h', 'i'}, "[104 105]"},
+			{UTF8Pass, [2]byte{'h', 'i'}, "hi"},
+			{UTF8Pass, &[3]byte{'h', 'i', 0xff}, "hi\xff"},
+			{UTF8Replace, invalid, "hi\ufffd"},
+			{UTF8Replace, &invalid, "hi\ufffd"},
+			{UTF8Replace, [3]byte{'h', 'i', 0xff}, "hi\ufffd"},
+			{UTF8Replace, "hi\xff", "hi\xff"},
+			{UTF8Error, []byte("hi"), "hi"},
+			{UTF8Error, 12, "12"},
+		}
+		for _, test := range tests {
+			c := Conv{UTF8: test.m}
+			got, err := c.String(test.from)
+			if err != nil {
+				t.Errorf("String(%v) unexpected err: %v", test.from, err)
+			} else if got != test.exp {
+				t.Errorf("String(%v) exp %q; got %q", test.from, test.exp, got)
+			}
+		}
+
+		c := Conv{UTF8: UTF8Error}
+		for _, from := range []interface{}{invalid, &invalid, [1]byte{0xff}} {
+			if got, err := c.String(from); err == nil {
+				t.Errorf("String(%v) exp err; got %q", from, got)
+			}
+		}
+		c.Encoding = EncodingHex
+		if got, err := c.String(invalid); err != nil || got != "6869ff" {
+			t.Errorf("String exp 6869ff; got %q (%v)", got, err)
+		}
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	t.Run("Normalize", func(t *testing.T) {
+		tests := []struct {
+			f    NormForm
+			from string
+			exp  string
+		}{
+			{NormNone, "e\u0301", "e\u0301"},
+			{NormNFC, "", ""},
+			{NormNFC, "ascii", "ascii"},
+			{NormNFC, "e\u0301", "\u00e9"},
+			{NormNFC, "\u00e9", "\u00e9"},
+			{NormNFC, "A\u030a", "\u00c5"},
+			{NormNFC, "\u212b", "\u00c5"},
+			{NormNFC, "\u2126", "\u03a9"},
+			{NormNFC, "\u1e9b\u0323", "\u1e9b\u0323"},
+			{NormNFC, "q\u0307\u0323", "q\u0323\u0307"},
+			{NormNFC, "\u1100\u1161\u11a8", "\uac01"},
+			{NormNFC, "\uac00\u11a8", "\uac01"},
+			{NormNFC, "\uac01", "\uac01"},
+			{NormNFC, "a\u0301\u0328", "\u0105\u0301"},
+			{NormNFC, "e\u0301\xff", "\u00e9\xff"},
+			{NormNFC, "\xff\u0301", "\xff\u0301"},
+			{NormNFC, "\ufb01", "\ufb01"},
+			{NormNFKC, "\ufb01", "fi"},
+			{NormNFKC, "x\u00b2", "x2"},
+			{NormNFKC, "\u1e9b\u0323", "\u1e69"},
+			{NormNFKC, "\uff21\uff22", "AB"},
+			{NormNFKC, "\u2460", "1"},
+			{NormNFKC, "\u3326", "\u30c9\u30eb"},
+		}
+		for _, test := range tests {
+			if got := test.f.Normalize(test.from); got != test.exp {
+				t.Errorf("Normalize(%+q) exp %+q; got %+q", test.from, test.exp, got)
+			}
+		}
+	})
+	t.Run("String", func(t *testing.T) {
+		c := Conv{Normalization: NormNFC}
+		if got, err := c.String("e\u0301"); err != nil || got != "\u00e9" {
+			t.Errorf("String exp %+q; got %+q (%v)", "\u00e9", got, err)
+		}
+		if got, err := c.String([]byte("e\u0301")); err != nil || got != "\u00e9" {
+			t.Errorf("String exp %+q; got %+q (%v)", "\u00e9", got, err)
+		}
+		c = Conv{Normalization: NormNFKC, UTF8: UTF8Replace}
+		if got, err := c.String([]byte("\ufb01\xff")); err != nil || got != "fi\ufffd" {
+			t.Errorf("String exp %+q; got %+q (%v)", "fi\ufffd", got, err)
+		}
+	})
+}
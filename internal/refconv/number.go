@@ -0,0 +1,168 @@
+//go:build go1.21
+
+package refconv
+
+import (
+	"fmt"
+	"math"
+	"unsafe"
+)
+
+// Integer is a constraint satisfied by the integer types and any type derived
+// from them.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint satisfied by the float types and any type derived from
+// them.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Real is a constraint satisfied by the integer and float types.
+type Real interface {
+	Integer | Float
+}
+
+// Number converts from to the numeric type To without the use of reflection,
+// values beyond the range of To are clamped, or rejected when Strict, in the
+// same way as the Conv methods of the same type. Floats are converted to
+// integers using the Rounding and NonFinite modes of c.
+func Number[To, From Real](c Conv, from From) (To, error) {
+	if isFloat[From]() {
+		f := float64(from)
+		if isFloat[To]() {
+			return numFloatToFloat[To](c, from, f)
+		}
+		return numFloatToInt[To](c, from, f)
+	}
+	if isFloat[To]() {
+		return To(from), nil
+	}
+
+	if isSigned[From]() {
+		v := int64(from)
+		if isSigned[To]() {
+			min, max := intBounds[To]()
+			if (v > max || v < min) && c.Strict {
+				return 0, newRangeErr(from, numTypeName[To]())
+			} else if v > max {
+				return To(max), nil
+			} else if v < min {
+				return To(min), nil
+			}
+			return To(v), nil
+		}
+		if v < 0 && c.Strict {
+			return 0, newRangeErr(from, numTypeName[To]())
+		} else if v < 0 {
+			return 0, nil
+		}
+		return numClampUint[To](c, from, uint64(v))
+	}
+
+	u := uint64(from)
+	if isSigned[To]() {
+		if _, max := intBounds[To](); u > uint64(max) && c.Strict {
+			return 0, newRangeErr(from, numTypeName[To]())
+		} else if u > uint64(max) {
+			return To(max), nil
+		}
+		return To(u), nil
+	}
+	return numClampUint[To](c, from, u)
+}
+
+func numFloatToFloat[To, From Real](c Conv, from From, f float64) (To, error) {
+	if unsafe.Sizeof(from) == 4 && unsafe.Sizeof(To(0)) == 4 &&
+		(c.NonFinite == NonFiniteDefault || !math.IsNaN(f) && !math.IsInf(f, 0)) {
+		return To(from), nil
+	}
+	f, err := c.convNonFinite(f, true)
+	if err != nil {
+		return 0, newConvErrReason(from, numTypeName[To](), err)
+	}
+	if unsafe.Sizeof(To(0)) == 4 {
+		if c.Strict && !math.IsInf(f, 0) && math.Abs(f) > math.MaxFloat32 {
+			return 0, newRangeErr(from, numTypeName[To]())
+		}
+		return To(c.convClampFloat32(f)), nil
+	}
+	return To(f), nil
+}
+
+func numFloatToInt[To, From Real](c Conv, from From, f float64) (To, error) {
+	f, err := c.convNonFinite(f, false)
+	if err == nil {
+		f, err = c.roundFloat(f)
+	}
+	switch {
+	case err != nil:
+		return 0, newConvErrReason(from, numTypeName[To](), err)
+	case math.IsNaN(f):
+		return 0, nil
+	case isSigned[To]():
+		// Infinities are clamped to the range of int64 before the range of To
+		// is checked, as they are by the Conv methods.
+		min, max := intBounds[To]()
+		if c.Strict && (f < float64(min) || f >= -float64(min)) &&
+			(!math.IsInf(f, 0) || max < math.MaxInt64) {
+			return 0, newRangeErr(from, numTypeName[To]())
+		}
+		if f >= float64(max) {
+			return To(max), nil
+		} else if f <= float64(min) {
+			return To(min), nil
+		}
+		return To(int64(f)), nil
+	case c.Strict && f < 0 && !math.IsInf(f, -1),
+		c.Strict && f >= float64(uintBound[To]())+1 &&
+			(!math.IsInf(f, 1) || uintBound[To]() < math.MaxUint64):
+		return 0, newRangeErr(from, numTypeName[To]())
+	case f <= 0:
+		return 0, nil
+	case f >= float64(uintBound[To]()):
+		return To(uintBound[To]()), nil
+	}
+	return To(uint64(f)), nil
+}
+
+func numClampUint[To, From Real](c Conv, from From, u uint64) (To, error) {
+	if max := uintBound[To](); u > max && c.Strict {
+		return 0, newRangeErr(from, numTypeName[To]())
+	} else if u > max {
+		return To(max), nil
+	}
+	return To(u), nil
+}
+
+// isFloat returns true if T is a float type.
+func isFloat[T Real]() bool {
+	var one T = 1
+	return one/2 != 0
+}
+
+// isSigned returns true if T is a signed integer or float type.
+func isSigned[T Real]() bool {
+	var zero T
+	return zero-1 < 0
+}
+
+// intBounds returns the range of the signed integer type T.
+func intBounds[T Real]() (min, max int64) {
+	bits := unsafe.Sizeof(T(0)) * 8
+	max = math.MaxInt64 >> (64 - bits)
+	return -max - 1, max
+}
+
+// uintBound returns the maximum value of the unsigned integer type T.
+func uintBound[T Real]() uint64 {
+	bits := unsafe.Sizeof(T(0)) * 8
+	return math.MaxUint64 >> (64 - bits)
+}
+
+func numTypeName[T Real]() string {
+	return fmt.Sprintf("%T", T(0))
+}
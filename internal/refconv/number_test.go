@@ -0,0 +1,108 @@
+//go:build go1.21
+
+package refconv
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func numberCheck[To, From Real](
+	t *testing.T, c Conv, fn func(interface{}) (To, error), from []From,
+) {
+	t.Helper()
+	for _, v := range from {
+		exp, experr := fn(v)
+		got, err := Number[To](c, v)
+		if (err != nil) != (experr != nil) {
+			t.Errorf("Number[%T](%T(%v)) exp err %v; got %v", got, v, v, experr, err)
+		} else if got != exp && !(got != got && exp != exp) {
+			t.Errorf("Number[%T](%T(%v)) exp %v; got %v", got, v, v, exp, got)
+		}
+	}
+}
+
+func numberChecks[From Real](t *testing.T, c Conv, from []From) {
+	t.Helper()
+	numberCheck(t, c, c.Int, from)
+	numberCheck(t, c, c.Int8, from)
+	numberCheck(t, c, c.Int16, from)
+	numberCheck(t, c, c.Int32, from)
+	numberCheck(t, c, c.Int64, from)
+	numberCheck(t, c, c.Uint, from)
+	numberCheck(t, c, c.Uint8, from)
+	numberCheck(t, c, c.Uint16, from)
+	numberCheck(t, c, c.Uint32, from)
+	numberCheck(t, c, c.Uint64, from)
+	numberCheck(t, c, c.Float32, from)
+	numberCheck(t, c, c.Float64, from)
+}
+
+func TestNumber(t *testing.T) {
+	ints := []int64{0, 1, -1, 127, 128, -128, -129, 255, 256, 65535, 65536,
+		math.MaxInt32, math.MaxInt32 + 1, math.MinInt32, math.MinInt32 - 1,
+		math.MaxUint32, math.MaxUint32 + 1, math.MaxInt64, math.MinInt64}
+	uints := []uint64{0, 1, 127, 128, 255, 256, 65535, 65536, math.MaxInt32,
+		math.MaxUint32, math.MaxUint32 + 1, math.MaxInt64, math.MaxInt64 + 1,
+		math.MaxUint64}
+	floats := []float64{0, 0.5, -0.5, 1.5, -1.5, 2.5, 127.9, 128, -128.9, -129,
+		255.5, 256, 1e10, -1e10, 1e19, 1e20, -1e20, math.MaxFloat32, 1e39, -1e39,
+		math.MaxFloat64, math.Inf(1), math.Inf(-1), math.NaN()}
+
+	convs := []Conv{
+		{},
+		{Rounding: RoundHalfEven},
+		{Rounding: RoundCeil},
+		{Rounding: RoundError},
+		{NonFinite: NonFiniteError},
+		{NonFinite: NonFiniteSaturate},
+		{NonFinite: NonFinitePass},
+		{Strict: true},
+		{Strict: true, NonFinite: NonFiniteSaturate},
+	}
+	for _, c := range convs {
+		numberChecks(t, c, ints)
+		numberChecks(t, c, uints)
+		numberChecks(t, c, floats)
+
+		i8 := make([]int8, 0, len(ints))
+		u16 := make([]uint16, 0, len(uints))
+		f32 := make([]float32, 0, len(floats))
+		for _, v := range ints {
+			i8 = append(i8, int8(v))
+		}
+		for _, v := range uints {
+			u16 = append(u16, uint16(v))
+		}
+		for _, v := range floats {
+			f32 = append(f32, float32(v))
+		}
+		numberChecks(t, c, i8)
+		numberChecks(t, c, u16)
+		numberChecks(t, c, f32)
+	}
+
+	t.Run("Named", func(t *testing.T) {
+		type Port uint16
+		type Ratio float64
+		got, err := Number[Port](Conv{}, Ratio(70000.5))
+		if err != nil || got != math.MaxUint16 {
+			t.Errorf("Number exp %v; got %v (%v)", math.MaxUint16, got, err)
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		c := Conv{Rounding: RoundError}
+		_, err := Number[int](c, 1.5)
+		var convErr *Error
+		if !errors.As(err, &convErr) || convErr.To != "int" || convErr.From != 1.5 {
+			t.Errorf("Number exp *Error from 1.5 to int; got %#v", err)
+		}
+
+		_, err = Number[int8](Conv{Strict: true}, 300)
+		if !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("Number exp range error; got %v", err)
+		}
+	})
+}
@@ -0,0 +1,401 @@
+// Code generated by go run gen.go. DO NOT EDIT.
+
+package refconv
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/cstockton/go-conv/decimal"
+)
+
+// BigFloatOr is like BigFloat but returns def on failure.
+func (c Conv) BigFloatOr(from interface{}, def *big.Float) *big.Float {
+	if v, err := c.BigFloat(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustBigFloat is like BigFloat but panics on failure.
+func (c Conv) MustBigFloat(from interface{}) *big.Float {
+	v, err := c.BigFloat(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// BigIntOr is like BigInt but returns def on failure.
+func (c Conv) BigIntOr(from interface{}, def *big.Int) *big.Int {
+	if v, err := c.BigInt(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustBigInt is like BigInt but panics on failure.
+func (c Conv) MustBigInt(from interface{}) *big.Int {
+	v, err := c.BigInt(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// BigRatOr is like BigRat but returns def on failure.
+func (c Conv) BigRatOr(from interface{}, def *big.Rat) *big.Rat {
+	if v, err := c.BigRat(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustBigRat is like BigRat but panics on failure.
+func (c Conv) MustBigRat(from interface{}) *big.Rat {
+	v, err := c.BigRat(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// BoolOr is like Bool but returns def on failure.
+func (c Conv) BoolOr(from interface{}, def bool) bool {
+	if v, err := c.Bool(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustBool is like Bool but panics on failure.
+func (c Conv) MustBool(from interface{}) bool {
+	v, err := c.Bool(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// BytesOr is like Bytes but returns def on failure.
+func (c Conv) BytesOr(from interface{}, def []byte) []byte {
+	if v, err := c.Bytes(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustBytes is like Bytes but panics on failure.
+func (c Conv) MustBytes(from interface{}) []byte {
+	v, err := c.Bytes(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Complex64Or is like Complex64 but returns def on failure.
+func (c Conv) Complex64Or(from interface{}, def complex64) complex64 {
+	if v, err := c.Complex64(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustComplex64 is like Complex64 but panics on failure.
+func (c Conv) MustComplex64(from interface{}) complex64 {
+	v, err := c.Complex64(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Complex128Or is like Complex128 but returns def on failure.
+func (c Conv) Complex128Or(from interface{}, def complex128) complex128 {
+	if v, err := c.Complex128(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustComplex128 is like Complex128 but panics on failure.
+func (c Conv) MustComplex128(from interface{}) complex128 {
+	v, err := c.Complex128(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DecimalOr is like Decimal but returns def on failure.
+func (c Conv) DecimalOr(from interface{}, def decimal.Decimal) decimal.Decimal {
+	if v, err := c.Decimal(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustDecimal is like Decimal but panics on failure.
+func (c Conv) MustDecimal(from interface{}) decimal.Decimal {
+	v, err := c.Decimal(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DurationOr is like Duration but returns def on failure.
+func (c Conv) DurationOr(from interface{}, def time.Duration) time.Duration {
+	if v, err := c.Duration(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustDuration is like Duration but panics on failure.
+func (c Conv) MustDuration(from interface{}) time.Duration {
+	v, err := c.Duration(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float32Or is like Float32 but returns def on failure.
+func (c Conv) Float32Or(from interface{}, def float32) float32 {
+	if v, err := c.Float32(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustFloat32 is like Float32 but panics on failure.
+func (c Conv) MustFloat32(from interface{}) float32 {
+	v, err := c.Float32(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64Or is like Float64 but returns def on failure.
+func (c Conv) Float64Or(from interface{}, def float64) float64 {
+	if v, err := c.Float64(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustFloat64 is like Float64 but panics on failure.
+func (c Conv) MustFloat64(from interface{}) float64 {
+	v, err := c.Float64(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// IntOr is like Int but returns def on failure.
+func (c Conv) IntOr(from interface{}, def int) int {
+	if v, err := c.Int(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustInt is like Int but panics on failure.
+func (c Conv) MustInt(from interface{}) int {
+	v, err := c.Int(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int8Or is like Int8 but returns def on failure.
+func (c Conv) Int8Or(from interface{}, def int8) int8 {
+	if v, err := c.Int8(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustInt8 is like Int8 but panics on failure.
+func (c Conv) MustInt8(from interface{}) int8 {
+	v, err := c.Int8(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int16Or is like Int16 but returns def on failure.
+func (c Conv) Int16Or(from interface{}, def int16) int16 {
+	if v, err := c.Int16(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustInt16 is like Int16 but panics on failure.
+func (c Conv) MustInt16(from interface{}) int16 {
+	v, err := c.Int16(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int32Or is like Int32 but returns def on failure.
+func (c Conv) Int32Or(from interface{}, def int32) int32 {
+	if v, err := c.Int32(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustInt32 is like Int32 but panics on failure.
+func (c Conv) MustInt32(from interface{}) int32 {
+	v, err := c.Int32(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int64Or is like Int64 but returns def on failure.
+func (c Conv) Int64Or(from interface{}, def int64) int64 {
+	if v, err := c.Int64(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustInt64 is like Int64 but panics on failure.
+func (c Conv) MustInt64(from interface{}) int64 {
+	v, err := c.Int64(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// StringOr is like String but returns def on failure.
+func (c Conv) StringOr(from interface{}, def string) string {
+	if v, err := c.String(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustString is like String but panics on failure.
+func (c Conv) MustString(from interface{}) string {
+	v, err := c.String(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// TimeOr is like Time but returns def on failure.
+func (c Conv) TimeOr(from interface{}, def time.Time) time.Time {
+	if v, err := c.Time(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustTime is like Time but panics on failure.
+func (c Conv) MustTime(from interface{}) time.Time {
+	v, err := c.Time(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// UintOr is like Uint but returns def on failure.
+func (c Conv) UintOr(from interface{}, def uint) uint {
+	if v, err := c.Uint(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustUint is like Uint but panics on failure.
+func (c Conv) MustUint(from interface{}) uint {
+	v, err := c.Uint(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Uint8Or is like Uint8 but returns def on failure.
+func (c Conv) Uint8Or(from interface{}, def uint8) uint8 {
+	if v, err := c.Uint8(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustUint8 is like Uint8 but panics on failure.
+func (c Conv) MustUint8(from interface{}) uint8 {
+	v, err := c.Uint8(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Uint16Or is like Uint16 but returns def on failure.
+func (c Conv) Uint16Or(from interface{}, def uint16) uint16 {
+	if v, err := c.Uint16(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustUint16 is like Uint16 but panics on failure.
+func (c Conv) MustUint16(from interface{}) uint16 {
+	v, err := c.Uint16(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Uint32Or is like Uint32 but returns def on failure.
+func (c Conv) Uint32Or(from interface{}, def uint32) uint32 {
+	if v, err := c.Uint32(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustUint32 is like Uint32 but panics on failure.
+func (c Conv) MustUint32(from interface{}) uint32 {
+	v, err := c.Uint32(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Uint64Or is like Uint64 but returns def on failure.
+func (c Conv) Uint64Or(from interface{}, def uint64) uint64 {
+	if v, err := c.Uint64(from); err == nil {
+		return v
+	}
+	return def
+}
+
+// MustUint64 is like Uint64 but panics on failure.
+func (c Conv) MustUint64(from interface{}) uint64 {
+	v, err := c.Uint64(from)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,225 @@
+package refconv
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cstockton/go-conv/internal/refutil"
+)
+
+// PathError is returned by Get when a path is invalid or a segment of it can
+// not be found, Segment is the part of the path which failed as written.
+type PathError struct {
+	Path    string
+	Segment string
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *PathError) Error() string {
+	return fmt.Sprintf("path %q at %q: %v", e.Path, e.Segment, e.Err)
+}
+
+// Unwrap returns the Err of this error.
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+type segmentKind uint8
+
+const (
+	segmentKey segmentKind = iota
+	segmentIndex
+	segmentWildcard
+)
+
+type pathSegment struct {
+	kind  segmentKind
+	text  string
+	key   string
+	index int
+}
+
+// parsePath splits path into its segments, each is a name following a dot or
+// an index, quoted key or wildcard within brackets.
+func parsePath(path string) ([]pathSegment, error) {
+	var segs []pathSegment
+	for i := 0; i < len(path); {
+		start := i
+		switch {
+		case path[i] == '[':
+			seg, n, err := parseBracket(path[i:])
+			if err != nil {
+				return nil, &PathError{Path: path, Segment: path[i:], Err: err}
+			}
+			segs = append(segs, seg)
+			i += n
+			continue
+		case path[i] == '.' && i > 0:
+			i++
+		case path[i] == '.' || i > 0:
+			return nil, &PathError{Path: path, Segment: path[i:], Err: ErrSyntax}
+		}
+
+		end := i + strings.IndexAny(path[i:], ".[")
+		if end < i {
+			end = len(path)
+		}
+		if end == i {
+			return nil, &PathError{Path: path, Segment: path[start:], Err: ErrSyntax}
+		}
+
+		seg := pathSegment{text: path[start:end], key: path[i:end]}
+		if seg.key == "*" {
+			seg.kind = segmentWildcard
+		}
+		segs = append(segs, seg)
+		i = end
+	}
+	return segs, nil
+}
+
+// parseBracket parses the bracketed segment at the start of s, returning it
+// along with the number of bytes consumed.
+func parseBracket(s string) (pathSegment, int, error) {
+	end := strings.IndexByte(s, ']')
+	switch {
+	case len(s) > 1 && s[1] == '"':
+		quoted, err := strconv.QuotedPrefix(s[1:])
+		if err != nil {
+			return pathSegment{}, 0, ErrSyntax
+		}
+		end = len(quoted) + 1
+	case len(s) > 1 && s[1] == '\'':
+		n := strings.IndexByte(s[2:], '\'')
+		if n < 0 {
+			return pathSegment{}, 0, ErrSyntax
+		}
+		end = n + 3
+	}
+	if end < 0 || end >= len(s) || s[end] != ']' || end < 2 {
+		return pathSegment{}, 0, ErrSyntax
+	}
+
+	inner := s[1:end]
+	seg := pathSegment{text: s[:end+1]}
+	switch inner[0] {
+	case '"':
+		seg.key, _ = strconv.Unquote(inner)
+	case '\'':
+		seg.key = inner[1 : len(inner)-1]
+	case '*':
+		if inner != "*" {
+			return pathSegment{}, 0, ErrSyntax
+		}
+		seg.kind = segmentWildcard
+	default:
+		if !isDigits(inner) {
+			return pathSegment{}, 0, ErrSyntax
+		}
+		i, err := strconv.Atoi(inner)
+		if err != nil {
+			return pathSegment{}, 0, ErrSyntax
+		}
+		seg.kind, seg.index = segmentIndex, i
+	}
+	return seg, end + 1, nil
+}
+
+// Get returns the value found by following path from root, which is usually
+// nested maps and slices such as those decoded from JSON. Segments of path are
+// names separated by dots, indexes within brackets such as "[0]" and keys
+// quoted within brackets such as `["a.b"]` or "['a.b']". A wildcard "*" or
+// "[*]" matches each element of a map, slice or struct, when a path contains
+// one the result is a []interface{} of every value found. Errors report the
+// segment of the path which failed as a *PathError.
+//
+// Example:
+//
+//   ports, err := c.Get(data, `servers[0].ports[*]`)
+func (c Conv) Get(root interface{}, path string) (interface{}, error) {
+	segs, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, multi := []interface{}{root}, false
+	for _, seg := range segs {
+		var next []interface{}
+		for _, v := range vals {
+			var res interface{}
+			switch seg.kind {
+			case segmentWildcard:
+				var elems []interface{}
+				elems, err = c.lookupAll(v)
+				next, multi = append(next, elems...), true
+			case segmentIndex:
+				res, err = c.lookupIndex(v, seg.index)
+				next = append(next, res)
+			default:
+				res, err = c.lookupKey(v, seg.key)
+				next = append(next, res)
+			}
+			if err != nil {
+				return nil, &PathError{Path: path, Segment: seg.text, Err: err}
+			}
+		}
+		vals = next
+	}
+	if multi {
+		return vals, nil
+	}
+	return vals[0], nil
+}
+
+// lookupAll returns each element of a map ordered by the String conversion of
+// its keys, each element of an array or slice, or each exported field of a
+// struct.
+func (c *Conv) lookupAll(from interface{}) ([]interface{}, error) {
+	value := refutil.IndirectVal(reflect.ValueOf(from))
+	var res []interface{}
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			if elem := value.Index(i); elem.CanInterface() {
+				res = append(res, elem.Interface())
+			}
+		}
+	case reflect.Map:
+		type entry struct {
+			key  string
+			elem interface{}
+		}
+		var entries []entry
+		iter := value.MapRange()
+		for iter.Next() {
+			k, elem := iter.Key(), iter.Value()
+			if !k.CanInterface() || !elem.CanInterface() {
+				continue
+			}
+			key, err := c.String(k.Interface())
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, entry{key, elem.Interface()})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+		for _, e := range entries {
+			res = append(res, e.elem)
+		}
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if field := value.Field(i); field.CanInterface() {
+				res = append(res, field.Interface())
+			}
+		}
+	default:
+		return nil, fmt.Errorf("cannot iterate %#v (type %[1]T)", from)
+	}
+	return res, nil
+}
@@ -0,0 +1,134 @@
+package refconv
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPath(t *testing.T) {
+	var c Conv
+	data := map[string]interface{}{
+		"a": map[string]interface{}{
+			"b": []interface{}{
+				map[string]interface{}{"c": "1"},
+				map[string]interface{}{"c": "2"},
+			},
+		},
+		"dotted.key": "d",
+		"ids":        map[int]string{7: "seven"},
+		"obj":        struct{ Name string }{"go"},
+		"*":          "star",
+		"y":          map[interface{}]interface{}{"k": "v"},
+	}
+
+	t.Run("Parse", func(t *testing.T) {
+		tests := []struct {
+			path string
+			exp  []pathSegment
+		}{
+			{"", nil},
+			{"a", []pathSegment{{text: "a", key: "a"}}},
+			{"a.b", []pathSegment{{text: "a", key: "a"}, {text: ".b", key: "b"}}},
+			{"[0]", []pathSegment{{kind: segmentIndex, text: "[0]"}}},
+			{"a[12]", []pathSegment{{text: "a", key: "a"},
+				{kind: segmentIndex, text: "[12]", index: 12}}},
+			{`["a.b"]`, []pathSegment{{text: `["a.b"]`, key: "a.b"}}},
+			{`["a\"]"]`, []pathSegment{{text: `["a\"]"]`, key: `a"]`}}},
+			{`['a"b']`, []pathSegment{{text: `['a"b']`, key: `a"b`}}},
+			{"a.*", []pathSegment{{text: "a", key: "a"},
+				{kind: segmentWildcard, text: ".*", key: "*"}}},
+			{"[*]", []pathSegment{{kind: segmentWildcard, text: "[*]"}}},
+		}
+		for _, test := range tests {
+			got, err := parsePath(test.path)
+			if err != nil {
+				t.Errorf("parsePath(%q) unexpected err: %v", test.path, err)
+			} else if !reflect.DeepEqual(got, test.exp) {
+				t.Errorf("parsePath(%q) exp %+v; got %+v", test.path, test.exp, got)
+			}
+		}
+		for _, path := range []string{".a", "a.", "a..b", "a[", "a[]", "a[x]",
+			"a[-1]", "a[0]b", `a["b]`, "a['b]", "a[**]", `a["b"x]`} {
+			got, err := parsePath(path)
+			var pathErr *PathError
+			if !errors.As(err, &pathErr) || !errors.Is(err, ErrSyntax) {
+				t.Errorf("parsePath(%q) exp *PathError; got %+v (%v)", path, got, err)
+			}
+		}
+	})
+	t.Run("Get", func(t *testing.T) {
+		tests := []struct {
+			path string
+			exp  interface{}
+		}{
+			{"", data},
+			{"a.b[1].c", "2"},
+			{`["dotted.key"]`, "d"},
+			{"['dotted.key']", "d"},
+			{"ids.7", "seven"},
+			{"ids[7]", "seven"},
+			{"obj.Name", "go"},
+			{"y.k", "v"},
+			{"['*']", "star"},
+			{"a.b[*].c", []interface{}{"1", "2"}},
+			{"a.*[0].c", []interface{}{"1"}},
+			{"obj.*", []interface{}{"go"}},
+		}
+		for _, test := range tests {
+			got, err := c.Get(data, test.path)
+			if err != nil {
+				t.Errorf("Get(%q) unexpected err: %v", test.path, err)
+			} else if !reflect.DeepEqual(got, test.exp) {
+				t.Errorf("Get(%q) exp %#v; got %#v", test.path, test.exp, got)
+			}
+		}
+		got, err := c.Get(data, "*")
+		if list, ok := got.([]interface{}); err != nil || !ok || len(list) != 6 ||
+			list[0] != "star" {
+			t.Errorf("Get(*) exp 6 values sorted by key; got %#v (%v)", got, err)
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			path string
+			seg  string
+		}{
+			{"x", "x"},
+			{"a.x", ".x"},
+			{"a.b[2]", "[2]"},
+			{"a.b[0].c.d", ".d"},
+			{"a.b[*].d", ".d"},
+			{"ids.x", ".x"},
+			{"obj.Age", ".Age"},
+			{"a.b[0].c[*]", "[*]"},
+		}
+		for _, test := range tests {
+			got, err := c.Get(data, test.path)
+			var pathErr *PathError
+			if !errors.As(err, &pathErr) {
+				t.Errorf("Get(%q) exp *PathError; got %#v (%v)", test.path, got, err)
+			} else if pathErr.Segment != test.seg || pathErr.Path != test.path {
+				t.Errorf("Get(%q) exp segment %q; got %q", test.path, test.seg,
+					pathErr.Segment)
+			}
+		}
+	})
+	t.Run("Value", func(t *testing.T) {
+		v := c.Value(data)
+		if got := v.Get("a.b[0].c").Int(); got != 1 {
+			t.Errorf("Int exp 1; got %v", got)
+		}
+		if got := v.Get("a.b[*].c").Slice(); len(got) != 2 || got[1].Int() != 2 {
+			t.Errorf("Slice exp [1 2]; got %v", got)
+		}
+		if err := v.Err(); err != nil {
+			t.Errorf("Err exp nil; got %v", err)
+		}
+		v.Get("a.x").Get("y").Int()
+		var pathErr *PathError
+		if err := v.Err(); !errors.As(err, &pathErr) || pathErr.Segment != ".x" {
+			t.Errorf("Err exp *PathError at .x; got %v", err)
+		}
+	})
+}
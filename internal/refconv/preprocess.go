@@ -0,0 +1,85 @@
+package refconv
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Preprocessor transforms a string before it is parsed, an error stops the
+// conversion.
+type Preprocessor func(s string) (string, error)
+
+// TrimSpace removes leading and trailing white space as defined by Unicode.
+func TrimSpace(s string) (string, error) {
+	return strings.TrimSpace(s), nil
+}
+
+// TrimQuotes removes a single pair of matching double, single or back quotes
+// surrounding s.
+func TrimQuotes(s string) (string, error) {
+	if len(s) >= 2 && s[0] == s[len(s)-1] && strings.IndexByte("\"'`", s[0]) >= 0 {
+		return s[1 : len(s)-1], nil
+	}
+	return s, nil
+}
+
+// FoldCase maps s to lower case. It is applied to every string parsed, so
+// inputs which are case sensitive such as times or the SI suffix M may no
+// longer parse.
+func FoldCase(s string) (string, error) {
+	return strings.ToLower(s), nil
+}
+
+// ExpandEnv returns a Preprocessor which replaces $var or ${var} within strings
+// by calling lookup, os.LookupEnv is used when lookup is nil. An error is
+// returned for variables which are not set.
+func ExpandEnv(lookup func(key string) (string, bool)) Preprocessor {
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+	return func(s string) (string, error) {
+		if strings.IndexByte(s, '$') < 0 {
+			return s, nil
+		}
+		var missing string
+		s = os.Expand(s, func(key string) string {
+			v, ok := lookup(key)
+			if !ok && missing == "" {
+				missing = key
+			}
+			return v
+		})
+		if missing != "" {
+			return "", fmt.Errorf("environment variable %q is not set", missing)
+		}
+		return s, nil
+	}
+}
+
+// preprocess applies the Preprocess chain of this Conv to s. The chain is then
+// cleared so that any conversions nested within the parsing of s do not apply
+// it again.
+func (c *Conv) preprocess(s string) (string, error) {
+	if len(c.Preprocess) == 0 {
+		return s, nil
+	}
+	return c.preprocessChain(s)
+}
+
+// preprocessChain is preprocess for a Conv with a Preprocess chain, it is kept
+// apart so the check for an empty chain is inlined.
+func (c *Conv) preprocessChain(s string) (string, error) {
+	v := s
+	for _, fn := range c.Preprocess {
+		var err error
+		if v, err = fn(v); err != nil {
+			return s, err
+		}
+	}
+	if v != s {
+		c.tracef("preprocessed %q to %q", s, v)
+	}
+	c.Preprocess = nil
+	return v, nil
+}
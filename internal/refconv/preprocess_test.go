@@ -0,0 +1,141 @@
+package refconv
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestPreprocess(t *testing.T) {
+	env := map[string]string{"PORT": "8080", "TIMEOUT": "5s", "QUOTED": `"1"`}
+	lookup := func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	}
+
+	t.Run("Preprocessors", func(t *testing.T) {
+		tests := []struct {
+			fn   Preprocessor
+			from string
+			exp  string
+		}{
+			{TrimSpace, " 42 \t\n", "42"},
+			{TrimSpace, "4 2", "4 2"},
+			{TrimQuotes, `"true"`, "true"},
+			{TrimQuotes, "'5s'", "5s"},
+			{TrimQuotes, "`5s`", "5s"},
+			{TrimQuotes, `""`, ""},
+			{TrimQuotes, `"'5'"`, "'5'"},
+			{TrimQuotes, `"5'`, `"5'`},
+			{TrimQuotes, `"`, `"`},
+			{FoldCase, "TRUE", "true"},
+			{FoldCase, "ÄB", "äb"},
+			{ExpandEnv(lookup), "${PORT}", "8080"},
+			{ExpandEnv(lookup), "$PORT", "8080"},
+			{ExpandEnv(lookup), "1${PORT}", "18080"},
+			{ExpandEnv(lookup), "42", "42"},
+		}
+		for _, test := range tests {
+			got, err := test.fn(test.from)
+			if err != nil {
+				t.Errorf("Preprocessor(%q) unexpected err: %v", test.from, err)
+			} else if got != test.exp {
+				t.Errorf("Preprocessor(%q) exp %q; got %q", test.from, test.exp, got)
+			}
+		}
+		if got, err := ExpandEnv(lookup)("${MISSING}"); err == nil {
+			t.Errorf("ExpandEnv exp err; got %q", got)
+		}
+	})
+	t.Run("LookupEnv", func(t *testing.T) {
+		os.Setenv("GOCONV_TEST_PORT", "8080")
+		defer os.Unsetenv("GOCONV_TEST_PORT")
+		if got, err := ExpandEnv(nil)("$GOCONV_TEST_PORT"); err != nil || got != "8080" {
+			t.Errorf("ExpandEnv exp 8080; got %q (%v)", got, err)
+		}
+	})
+	t.Run("Conv", func(t *testing.T) {
+		c := Conv{Preprocess: []Preprocessor{TrimSpace, TrimQuotes, ExpandEnv(lookup)}}
+		if got, err := c.Int(" 42 "); err != nil || got != 42 {
+			t.Errorf("Int exp 42; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint16(` "${PORT}" `); err != nil || got != 8080 {
+			t.Errorf("Uint16 exp 8080; got %v (%v)", got, err)
+		}
+		if got, err := c.Bool(`"true"`); err != nil || !got {
+			t.Errorf("Bool exp true; got %v (%v)", got, err)
+		}
+		if got, err := c.Duration("'5s'"); err != nil || got != 5*time.Second {
+			t.Errorf("Duration exp 5s; got %v (%v)", got, err)
+		}
+		if got, err := c.Duration("$TIMEOUT"); err != nil || got != 5*time.Second {
+			t.Errorf("Duration exp 5s; got %v (%v)", got, err)
+		}
+		if got, err := c.Float32(" 1.5 "); err != nil || got != 1.5 {
+			t.Errorf("Float32 exp 1.5; got %v (%v)", got, err)
+		}
+		if got, err := c.Complex128(" 1+2i "); err != nil || got != 1+2i {
+			t.Errorf("Complex128 exp 1+2i; got %v (%v)", got, err)
+		}
+		if got, err := c.BigInt(" 42 "); err != nil || got.Int64() != 42 {
+			t.Errorf("BigInt exp 42; got %v (%v)", got, err)
+		}
+		if got, err := c.BigFloat(" 1.5 "); err != nil || got.String() != "1.5" {
+			t.Errorf("BigFloat exp 1.5; got %v (%v)", got, err)
+		}
+		if got, err := c.Bytes(" hi "); err != nil || string(got) != "hi" {
+			t.Errorf("Bytes exp hi; got %q (%v)", got, err)
+		}
+		exp := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+		if got, err := c.Time(` "2020-01-02T00:00:00Z" `); err != nil || !got.Equal(exp) {
+			t.Errorf("Time exp %v; got %v (%v)", exp, got, err)
+		}
+		if got, err := c.String(" 42 "); err != nil || got != " 42 " {
+			t.Errorf("String exp unchanged; got %q (%v)", got, err)
+		}
+
+		// Preprocessors are applied once in order, so quotes are removed before
+		// variables are expanded.
+		if got, err := c.Int(`"'1'"`); err == nil {
+			t.Errorf("Int exp err; got %v", got)
+		}
+		if got, err := c.Int("$QUOTED"); err == nil {
+			t.Errorf("Int exp err; got %v", got)
+		}
+		c.Preprocess = []Preprocessor{ExpandEnv(lookup), TrimQuotes}
+		if got, err := c.Int("$QUOTED"); err != nil || got != 1 {
+			t.Errorf("Int exp 1; got %v (%v)", got, err)
+		}
+
+		for _, from := range []interface{}{"$MISSING", "${MISSING}s"} {
+			if got, err := c.Int64(from); err == nil {
+				t.Errorf("Int64(%v) exp err; got %v", from, got)
+			}
+			if got, err := c.Duration(from); err == nil {
+				t.Errorf("Duration(%v) exp err; got %v", from, got)
+			}
+			if got, err := c.Time(from); err == nil {
+				t.Errorf("Time(%v) exp err; got %v", from, got)
+			}
+		}
+
+		c = Conv{Preprocess: []Preprocessor{FoldCase}}
+		if got, err := c.Bool("TRUE"); err != nil || !got {
+			t.Errorf("Bool exp true; got %v (%v)", got, err)
+		}
+
+		c = Conv{Preprocess: []Preprocessor{TrimSpace}, Empty: EmptyZero}
+		if got, err := c.Int("  "); err != nil || got != 0 {
+			t.Errorf("Int exp 0; got %v (%v)", got, err)
+		}
+
+		fail := errors.New("fail")
+		c = Conv{Preprocess: []Preprocessor{func(string) (string, error) {
+			return "", fail
+		}}}
+		if _, err := c.Int64("42"); !errors.Is(err, fail) {
+			t.Errorf("Int64 exp err %v; got %v", fail, err)
+		}
+	})
+}
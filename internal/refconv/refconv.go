@@ -3,14 +3,126 @@
 package refconv
 
 import (
+	"errors"
 	"fmt"
 )
 
 // Conv implements the Converter interface by using the reflection package. It
 // will never panic, does not require initialization or share state so is safe
-// for use by multiple Goroutines.
-type Conv struct{}
+// for use by multiple Goroutines. The zero value is ready to use, each field
+// may be set to change how conversions are performed.
+type Conv struct {
+
+	// Locale is used when parsing numeric strings, when it is the zero value
+	// numbers are parsed by the strconv package as is.
+	Locale Locale
+
+	// Affixes configures the decorations such as percent signs or currency
+	// symbols which may surround numeric strings.
+	Affixes Affixes
+
+	// Suffixes controls whether numeric strings may end with a magnitude such
+	// as "2.5k" or "512MiB".
+	Suffixes SuffixMode
+
+	// Rounding controls how fractional numbers are converted to integers, the
+	// zero value truncates toward zero.
+	Rounding RoundingMode
+
+	// NonFinite controls how NaN and infinite values are converted, the zero
+	// value keeps the behavior of each target type.
+	NonFinite NonFiniteMode
+
+	// StrictImaginary returns an error when converting a complex number with a
+	// non-zero imaginary part to a real number, rather than dropping it.
+	StrictImaginary bool
+
+	// DecimalScale is the number of fractional digits given to the results of
+	// Decimal conversions, when zero the smallest scale which represents the
+	// value exactly is used.
+	DecimalScale int32
+
+	// Encoding is used to decode strings by Bytes and to encode bytes by
+	// String, when it is the zero value they are cast as is.
+	Encoding Encoding
+
+	// UTF8 controls how bytes which are not valid UTF-8 are converted to
+	// strings, the zero value casts them as is.
+	UTF8 UTF8Mode
+
+	// Normalization is the Unicode normalization form applied to the results of
+	// String conversions, the zero value leaves them unchanged.
+	Normalization NormForm
+
+	// Binary controls whether byte slices and arrays are decoded as binary
+	// numbers by integer and float conversions, the zero value converts them
+	// to their length.
+	Binary BinaryMode
+
+	// Length controls how arrays, slices, maps and channels are converted to
+	// scalar values, the zero value converts them to their length.
+	Length LengthMode
+
+	// Empty controls how nil values, empty strings and literals such as "null"
+	// are converted, the zero value keeps the behavior of each target type.
+	Empty EmptyMode
+
+	// Preprocess is a chain of functions applied in order to each string
+	// before it is parsed, for example to trim white space or quotes.
+	Preprocess []Preprocessor
+
+	// Strict limits each target to its own canonical string syntax and
+	// disables conversions between kinds such as bools and numbers, the
+	// lengths of collections and the Time field of structs. Finite numbers
+	// beyond the range of an integer or float32 target return an error rather
+	// than being clamped.
+	Strict bool
+
+	// GuessTypes is the set of types considered by Guess and GuessColumn, the
+	// zero value considers all of them.
+	GuessTypes GuessType
+
+	// Trace is called with a description of each step taken by a conversion,
+	// such as the parsers tried or any clamping applied, when it is non-nil.
+	Trace func(step string)
+}
+
+// Error is returned when a value can not be converted, Reason is the cause of
+// the failure when one is known.
+type Error struct {
+	From   interface{}
+	To     string
+	Reason error
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Reason == nil {
+		return fmt.Sprintf("cannot convert %#v (type %[1]T) to %v", e.From, e.To)
+	}
+	return fmt.Sprintf("cannot convert %#v (type %[1]T) to %v: %v",
+		e.From, e.To, e.Reason)
+}
+
+// Unwrap returns the Reason of this error.
+func (e *Error) Unwrap() error {
+	return e.Reason
+}
 
 func newConvErr(from interface{}, to string) error {
-	return fmt.Errorf("cannot convert %#v (type %[1]T) to %v", from, to)
+	return &Error{From: from, To: to}
+}
+
+func newConvErrReason(from interface{}, to string, reason error) error {
+	return &Error{From: from, To: to, Reason: reason}
+}
+
+// newConvErrFrom returns an error for a conversion to a narrower type which
+// failed with err, the reason of err is kept when it is an *Error.
+func newConvErrFrom(from interface{}, to string, err error) error {
+	var e *Error
+	if errors.As(err, &e) {
+		return newConvErrReason(from, to, e.Reason)
+	}
+	return newConvErr(from, to)
 }
@@ -47,6 +47,8 @@ func TestMain(m *testing.M) {
 func TestConv(t *testing.T) {
 	var c Conv
 	testconv.RunBoolTests(t, c.Bool)
+	testconv.RunComplex64Tests(t, c.Complex64)
+	testconv.RunComplex128Tests(t, c.Complex128)
 	testconv.RunDurationTests(t, c.Duration)
 	testconv.RunFloat32Tests(t, c.Float32)
 	testconv.RunFloat64Tests(t, c.Float64)
@@ -80,7 +82,7 @@ func TestConvHelpers(t *testing.T) {
 		}
 	})
 	t.Run("timeFromString", func(t *testing.T) {
-		if _, ok := convStringToTime(""); ok {
+		if _, _, ok := convStringToTime(""); ok {
 			t.Fatal("expected timeFromString to return false on 0 len str")
 		}
 	})
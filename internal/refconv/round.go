@@ -0,0 +1,140 @@
+package refconv
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode controls how the fractional part of a number is handled when
+// converting to an integer.
+type RoundingMode uint8
+
+// Rounding modes, the zero value truncates toward zero.
+const (
+	RoundTruncate RoundingMode = iota // 2.9 is 2, -2.9 is -2
+	RoundError                        // 2.9 is an error, 2.0 is 2
+	RoundFloor                        // 2.9 is 2, -2.1 is -3
+	RoundCeil                         // 2.1 is 3, -2.9 is -2
+	RoundHalfUp                       // 2.5 is 3, -2.5 is -3
+	RoundHalfEven                     // 2.5 is 2, 3.5 is 4, -2.5 is -2
+)
+
+var errFraction = errors.New("value has a fractional part")
+
+// roundFloat returns f rounded to an integral value by the rounding mode of
+// this Conv.
+func (c *Conv) roundFloat(f float64) (float64, error) {
+	switch c.Rounding {
+	case RoundError:
+		if f != math.Trunc(f) && !math.IsInf(f, 0) && !math.IsNaN(f) {
+			return 0, errFraction
+		}
+		return f, nil
+	case RoundFloor:
+		return math.Floor(f), nil
+	case RoundCeil:
+		return math.Ceil(f), nil
+	case RoundHalfUp:
+		return math.Round(f), nil
+	case RoundHalfEven:
+		return math.RoundToEven(f), nil
+	default:
+		return math.Trunc(f), nil
+	}
+}
+
+// traceRound is like roundFloat, reporting when f is changed by rounding.
+func (c *Conv) traceRound(f float64) (float64, error) {
+	r, err := c.roundFloat(f)
+	if err == nil && r != f && c.Trace != nil {
+		c.tracef("rounded %v to %v", f, r)
+	}
+	return r, err
+}
+
+// roundDigits returns the magnitude of an integer rounded by the fractional
+// digits frac which followed it, using the rounding mode of this Conv.
+func (c *Conv) roundDigits(mag uint64, neg bool, frac string) (uint64, error) {
+	frac = strings.TrimRight(frac, "0")
+	if len(frac) == 0 {
+		return mag, nil
+	}
+
+	var up bool
+	switch c.Rounding {
+	case RoundError:
+		return 0, errFraction
+	case RoundFloor:
+		up = neg
+	case RoundCeil:
+		up = !neg
+	case RoundHalfUp:
+		up = frac[0] >= '5'
+	case RoundHalfEven:
+		up = frac[0] > '5' || (frac[0] == '5' && (len(frac) > 1 || mag%2 == 1))
+	}
+	if up {
+		if mag == math.MaxUint64 {
+			return 0, strconv.ErrRange
+		}
+		mag++
+	}
+	if c.Trace != nil {
+		c.tracef("rounded away the fraction .%v", frac)
+	}
+	return mag, nil
+}
+
+// convFloatToInt64 rounds f by the rounding mode of this Conv, values beyond
+// the range of an int64 are clamped.
+func (c *Conv) convFloatToInt64(from interface{}, f float64) (int64, error) {
+	f, err := c.convNonFinite(f, false)
+	if err == nil {
+		f, err = c.traceRound(f)
+	}
+	switch {
+	case err != nil:
+		return 0, newConvErrReason(from, "int64", err)
+	case math.IsNaN(f):
+		c.tracef("converted NaN to 0")
+		return 0, nil
+	case c.Strict && !math.IsInf(f, 0) && (f >= math.MaxInt64 || f < math.MinInt64):
+		return 0, newRangeErr(from, "int64")
+	case f >= math.MaxInt64:
+		c.tracef("clamped %v to the range of int64", f)
+		return math.MaxInt64, nil
+	case f <= math.MinInt64:
+		c.tracef("clamped %v to the range of int64", f)
+		return math.MinInt64, nil
+	}
+	return int64(f), nil
+}
+
+// convFloatToUint64 rounds f by the rounding mode of this Conv, values beyond
+// the range of an uint64 are clamped.
+func (c *Conv) convFloatToUint64(from interface{}, f float64) (uint64, error) {
+	f, err := c.convNonFinite(f, false)
+	if err == nil {
+		f, err = c.traceRound(f)
+	}
+	switch {
+	case err != nil:
+		return 0, newConvErrReason(from, "uint64", err)
+	case math.IsNaN(f):
+		c.tracef("converted NaN to 0")
+		return 0, nil
+	case c.Strict && !math.IsInf(f, 0) && (f >= math.MaxUint64 || f < 0):
+		return 0, newRangeErr(from, "uint64")
+	case f < 0:
+		c.tracef("clamped %v to the range of uint64", f)
+		return 0, nil
+	case f == 0:
+		return 0, nil
+	case f >= math.MaxUint64:
+		c.tracef("clamped %v to the range of uint64", f)
+		return math.MaxUint64, nil
+	}
+	return uint64(f), nil
+}
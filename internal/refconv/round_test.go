@@ -0,0 +1,102 @@
+package refconv
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestRounding(t *testing.T) {
+	modes := []RoundingMode{
+		RoundTruncate, RoundFloor, RoundCeil, RoundHalfUp, RoundHalfEven}
+	tests := []struct {
+		from float64
+		exp  []int64 // in order of modes
+	}{
+		{0, []int64{0, 0, 0, 0, 0}},
+		{2, []int64{2, 2, 2, 2, 2}},
+		{2.1, []int64{2, 2, 3, 2, 2}},
+		{2.5, []int64{2, 2, 3, 3, 2}},
+		{2.9, []int64{2, 2, 3, 3, 3}},
+		{3.5, []int64{3, 3, 4, 4, 4}},
+		{-2.1, []int64{-2, -3, -2, -2, -2}},
+		{-2.5, []int64{-2, -3, -2, -3, -2}},
+		{-2.9, []int64{-2, -3, -2, -3, -3}},
+		{-3.5, []int64{-3, -4, -3, -4, -4}},
+		{2.51, []int64{2, 2, 3, 3, 3}},
+		{-0.5, []int64{0, -1, 0, -1, 0}},
+	}
+	for i, mode := range modes {
+		c := Conv{Rounding: mode}
+		for _, test := range tests {
+			exp := test.exp[i]
+			str := fmt.Sprint(test.from)
+			if got, err := c.Int64(test.from); err != nil || got != exp {
+				t.Errorf("mode %v: Int64(%v) exp %v; got %v (%v)",
+					mode, test.from, exp, got, err)
+			}
+			if got, err := c.Int64(complex(test.from, 0)); err != nil || got != exp {
+				t.Errorf("mode %v: Int64(complex(%v)) exp %v; got %v (%v)",
+					mode, test.from, exp, got, err)
+			}
+			if got, err := c.Int64(str); err != nil || got != exp {
+				t.Errorf("mode %v: Int64(%q) exp %v; got %v (%v)",
+					mode, str, exp, got, err)
+			}
+			if exp < 0 {
+				exp = 0
+			}
+			if got, err := c.Uint64(test.from); err != nil || got != uint64(exp) {
+				t.Errorf("mode %v: Uint64(%v) exp %v; got %v (%v)",
+					mode, test.from, exp, got, err)
+			}
+			if got, err := c.Uint64(str); err != nil || got != uint64(exp) {
+				t.Errorf("mode %v: Uint64(%q) exp %v; got %v (%v)",
+					mode, str, exp, got, err)
+			}
+		}
+	}
+
+	t.Run("RoundError", func(t *testing.T) {
+		c := Conv{Rounding: RoundError}
+		if got, err := c.Int64(2.0); err != nil || got != 2 {
+			t.Errorf("Int64 exp 2; got %v (%v)", got, err)
+		}
+		if got, err := c.Int8(2.5); err == nil {
+			t.Errorf("Int8 exp err; got %v", got)
+		}
+		if got, err := c.Uint64(float32(2.5)); err == nil {
+			t.Errorf("Uint64 exp err; got %v", got)
+		}
+		if got, err := c.Uint32(complex(2.5, 0)); err == nil {
+			t.Errorf("Uint32 exp err; got %v", got)
+		}
+		if got, err := c.Int64(math.Inf(1)); err != nil || got != math.MaxInt64 {
+			t.Errorf("Int64 exp MaxInt64; got %v (%v)", got, err)
+		}
+	})
+	t.Run("Overflow", func(t *testing.T) {
+		c := Conv{Rounding: RoundCeil}
+		if got, err := c.Uint64("18446744073709551615.1"); err == nil {
+			t.Errorf("Uint64 exp range err; got %v", got)
+		}
+		if got, err := c.Int64("9223372036854775807.1"); err == nil {
+			t.Errorf("Int64 exp range err; got %v", got)
+		}
+		if got, err := c.Int64(1e300); err != nil || got != math.MaxInt64 {
+			t.Errorf("Int64 exp MaxInt64; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64(-1e300); err != nil || got != math.MinInt64 {
+			t.Errorf("Int64 exp MinInt64; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64(1e300); err != nil || got != math.MaxUint64 {
+			t.Errorf("Uint64 exp MaxUint64; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64(math.NaN()); err != nil || got != 0 {
+			t.Errorf("Int64 exp 0; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64(math.NaN()); err != nil || got != 0 {
+			t.Errorf("Uint64 exp 0; got %v (%v)", got, err)
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package refconv
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Reasons for the errors returned when Strict is set.
+var (
+
+	// ErrSyntax is returned for strings which do not match the canonical
+	// syntax of the target type.
+	ErrSyntax = errors.New("invalid syntax")
+
+	// ErrUnsupported is returned for conversions between kinds which are
+	// disabled, such as bools to numbers.
+	ErrUnsupported = errors.New("conversion is not supported in strict mode")
+)
+
+// isIntSyntax returns true if the canonical numeric string s is an integer
+// without a fraction or exponent.
+func isIntSyntax(s string, signed bool) bool {
+	if len(s) > 0 && (s[0] == '+' || (signed && s[0] == '-')) {
+		s = s[1:]
+	}
+	return len(s) > 0 && isDigits(s)
+}
+
+// isFloatSyntax returns true if the canonical numeric string s is a decimal
+// number with an optional sign, fraction and exponent, such as "-1.5e3".
+func isFloatSyntax(s string) bool {
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	mant, exp := s, ""
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mant, exp = s[:i], s[i+1:]
+		if len(exp) > 0 && (exp[0] == '+' || exp[0] == '-') {
+			exp = exp[1:]
+		}
+		if len(exp) == 0 || !isDigits(exp) {
+			return false
+		}
+	}
+	if i := strings.IndexByte(mant, '.'); i >= 0 {
+		mant = mant[:i] + mant[i+1:]
+	}
+	return len(mant) > 0 && isDigits(mant)
+}
+
+// isRangeChecked returns true if from must be within the range of an integer
+// or float32 target rather than clamped to it, which is the case for every
+// finite value when Strict and for math/big and decimal values.
+func (c *Conv) isRangeChecked(from interface{}) bool {
+	return c.Strict || isBig(from)
+}
+
+// newRangeErr returns the error for a value beyond the range of the numeric
+// type to which is range checked.
+func newRangeErr(from interface{}, to string) error {
+	return newConvErrReason(from, to, strconv.ErrRange)
+}
+
+// newUnsupportedErr returns the error for a value with no conversion to the
+// type to, which has the reason ErrUnsupported when Strict.
+func (c *Conv) newUnsupportedErr(from interface{}, to string) error {
+	if c.Strict {
+		return newConvErrReason(from, to, ErrUnsupported)
+	}
+	return newConvErr(from, to)
+}
@@ -0,0 +1,145 @@
+package refconv
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestStrict(t *testing.T) {
+	c := Conv{Strict: true}
+
+	t.Run("Valid", func(t *testing.T) {
+		if got, err := c.Int64("-42"); err != nil || got != -42 {
+			t.Errorf("Int64 exp -42; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64("+42"); err != nil || got != 42 {
+			t.Errorf("Uint64 exp 42; got %v (%v)", got, err)
+		}
+		if got, err := c.Float64("1.5e3"); err != nil || got != 1500 {
+			t.Errorf("Float64 exp 1500; got %v (%v)", got, err)
+		}
+		if got, err := c.Bool("TRUE"); err != nil || !got {
+			t.Errorf("Bool exp true; got %v (%v)", got, err)
+		}
+		if got, err := c.Complex128("1+2i"); err != nil || got != 1+2i {
+			t.Errorf("Complex128 exp (1+2i); got %v (%v)", got, err)
+		}
+		if got, err := c.Duration("1m30s"); err != nil || got != 90*time.Second {
+			t.Errorf("Duration exp 1m30s; got %v (%v)", got, err)
+		}
+		if got, err := c.Duration(int64(time.Second)); err != nil || got != time.Second {
+			t.Errorf("Duration exp 1s; got %v (%v)", got, err)
+		}
+		exp := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+		if got, err := c.Time("2006-01-02T15:04:05Z"); err != nil || !got.Equal(exp) {
+			t.Errorf("Time exp %v; got %v (%v)", exp, got, err)
+		}
+		if got, err := c.BigInt("12345678901234567890123"); err != nil ||
+			got.String() != "12345678901234567890123" {
+			t.Errorf("BigInt exp 12345678901234567890123; got %v (%v)", got, err)
+		}
+		if got, err := c.Int(1.5); err != nil || got != 1 {
+			t.Errorf("Int exp 1; got %v (%v)", got, err)
+		}
+		if got, err := c.Int8(-128); err != nil || got != math.MinInt8 {
+			t.Errorf("Int8 exp MinInt8; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64(-0.5); err != nil || got != 0 {
+			t.Errorf("Uint64 exp 0; got %v (%v)", got, err)
+		}
+		if got, err := c.Float64(".5"); err != nil || got != 0.5 {
+			t.Errorf("Float64 exp 0.5; got %v (%v)", got, err)
+		}
+		nonFinite := Conv{Strict: true, NonFinite: NonFiniteSaturate}
+		if got, err := nonFinite.Float64("-Infinity"); err != nil || got != -math.MaxFloat64 {
+			t.Errorf("Float64 exp -MaxFloat64; got %v (%v)", got, err)
+		}
+		unwrap := Conv{Strict: true, Length: LengthUnwrap}
+		if got, err := unwrap.Int([]string{"42"}); err != nil || got != 42 {
+			t.Errorf("Int exp 42; got %v (%v)", got, err)
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		type timeField struct{ Time time.Time }
+		tests := []struct {
+			fn     func(interface{}) (interface{}, error)
+			from   interface{}
+			reason error
+		}{
+			{func(v interface{}) (interface{}, error) { return c.Int(v) }, "yes", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Int(v) }, "3.99", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Int8(v) }, "1e2", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Int64(v) }, true, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Int64(v) }, []int{1}, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Uint64(v) }, "-1", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Uint64(v) }, "T", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Uint(v) }, false, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Float64(v) }, "T", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Float64(v) }, "1_000", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Float64(v) }, "0x1p4", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Float64(v) }, "Infinity", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Float32(v) }, "1e", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Float32(v) }, true, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Float64(v) }, map[int]int{}, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Bool(v) }, "yes", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Bool(v) }, 1, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Bool(v) }, []int{1}, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Complex128(v) }, "T", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Complex64(v) }, true, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Duration(v) }, "60", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Time(v) }, "Mon, 02 Jan 2006 15:04:05", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.Time(v) }, timeField{}, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.BigInt(v) }, "1.5", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.BigRat(v) }, "yes", ErrSyntax},
+			{func(v interface{}) (interface{}, error) { return c.BigFloat(v) }, true, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Bool(v) }, time.Time{}, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Duration(v) }, true, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Duration(v) }, []string{"1s"}, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Time(v) }, 5, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Int64(v) }, struct{}{}, ErrUnsupported},
+			{func(v interface{}) (interface{}, error) { return c.Uint64(v) }, -5, strconv.ErrRange},
+			{func(v interface{}) (interface{}, error) { return c.Uint64(v) }, -1.5, strconv.ErrRange},
+			{func(v interface{}) (interface{}, error) { return c.Int64(v) }, uint64(1 << 63), strconv.ErrRange},
+			{func(v interface{}) (interface{}, error) { return c.Int64(v) }, 1e19, strconv.ErrRange},
+			{func(v interface{}) (interface{}, error) { return c.Int8(v) }, 300, strconv.ErrRange},
+			{func(v interface{}) (interface{}, error) { return c.Uint16(v) }, "65536", strconv.ErrRange},
+			{func(v interface{}) (interface{}, error) { return c.Float32(v) }, 1e39, strconv.ErrRange},
+		}
+		for _, test := range tests {
+			got, err := test.fn(test.from)
+			if err == nil {
+				t.Errorf("(%#v) exp err; got %v", test.from, got)
+				continue
+			}
+			var convErr *Error
+			if !errors.As(err, &convErr) {
+				t.Errorf("(%#v) exp *Error; got %T", test.from, err)
+			} else if convErr.From == nil || convErr.To == "" {
+				t.Errorf("(%#v) exp From and To to be set; got %#v", test.from, convErr)
+			}
+			if !errors.Is(err, test.reason) {
+				t.Errorf("(%#v) exp reason %v; got %v", test.from, test.reason, err)
+			}
+		}
+	})
+	t.Run("Lenient", func(t *testing.T) {
+		var c Conv
+		if got, err := c.Int("yes"); err != nil || got != 1 {
+			t.Errorf("Int exp 1; got %v (%v)", got, err)
+		}
+		if got, err := c.Int("3.99"); err != nil || got != 3 {
+			t.Errorf("Int exp 3; got %v (%v)", got, err)
+		}
+		if got, err := c.Float64("T"); err != nil || got != 1 {
+			t.Errorf("Float64 exp 1; got %v (%v)", got, err)
+		}
+		_, err := c.Int("foo")
+		var convErr *Error
+		if !errors.As(err, &convErr) || convErr.To != "int" {
+			t.Errorf("Int exp *Error to int; got %#v", err)
+		}
+	})
+}
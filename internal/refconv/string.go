@@ -16,44 +16,82 @@ type stringConverter interface {
 }
 
 // String returns the string representation from the given interface{} value
-// and can not currently fail. Although an error is currently provided only for
-// API cohesion you should still check it to be future proof.
+// and can only fail for bytes which are not valid UTF-8 when the UTF8 mode of
+// this Conv is UTF8Error, you should still check the error to be future proof.
+// Byte slices are encoded using the Encoding of this Conv, when it or the UTF8
+// mode is set byte arrays are as well. The result is normalized to the Unicode
+// normalization form of this Conv.
 func (c Conv) String(from interface{}) (string, error) {
+	if empty, err := c.convEmpty(from, "string"); empty {
+		return "", err
+	}
+	s, err := c.convString(from)
+	if err != nil {
+		return "", err
+	}
+	return c.Normalization.Normalize(s), nil
+}
+
+func (c *Conv) convString(from interface{}) (string, error) {
 	switch T := from.(type) {
 	case string:
 		return T, nil
 	case stringConverter:
+		c.traceHook(from, "String")
 		return T.String()
 	case []byte:
-		return string(T), nil
+		return c.convBytesToStr(from, T)
 	case *[]byte:
-		// @TODO Maybe validate the bytes are valid runes
-		return string(*T), nil
+		return c.convBytesToStr(from, *T)
 	case *string:
 		return *T, nil
-	default:
-		return fmt.Sprintf("%v", from), nil
 	}
+	if c.Encoding != EncodingNone || c.UTF8 != UTF8Default {
+		if value := refutil.IndirectVal(reflect.ValueOf(from)); isKindBytes(value) {
+			return c.convBytesToStr(from, c.convValToBytes(value))
+		}
+	}
+	return fmt.Sprintf("%v", from), nil
+}
+
+func (c *Conv) convBytesToStr(from interface{}, b []byte) (string, error) {
+	if c.Encoding != EncodingNone {
+		return c.Encoding.Encode(b), nil
+	}
+	s, err := c.UTF8.Valid(string(b))
+	if err != nil {
+		return "", newConvErrReason(from, "string", err)
+	}
+	return s, nil
 }
 
-func (c Conv) convNumToBool(k reflect.Kind, value reflect.Value) (bool, bool) {
+func (c *Conv) convNumToBool(k reflect.Kind, value reflect.Value) (bool, bool) {
 	switch {
 	case refutil.IsKindInt(k):
 		return 0 != value.Int(), true
 	case refutil.IsKindUint(k):
 		return 0 != value.Uint(), true
 	case refutil.IsKindFloat(k):
-		T := value.Float()
-		if math.IsNaN(T) {
-			return false, true
-		}
-		return 0 != T, true
+		return c.convFloatToBool(value.Float())
 	case refutil.IsKindComplex(k):
 		T := value.Complex()
 		if cmplx.IsNaN(T) {
-			return false, true
+			return c.convFloatToBool(math.NaN())
+		}
+		if f, err := c.convComplexToReal(T); err == nil {
+			return c.convFloatToBool(f)
 		}
-		return 0 != real(T), true
 	}
 	return false, false
 }
+
+func (c *Conv) convFloatToBool(f float64) (bool, bool) {
+	f, err := c.convNonFinite(f, false)
+	if err != nil {
+		return false, false
+	}
+	if math.IsNaN(f) {
+		return false, true
+	}
+	return 0 != f, true
+}
@@ -0,0 +1,133 @@
+package refconv
+
+import (
+	"math/big"
+	"strings"
+)
+
+// SuffixMode controls which magnitude suffixes are accepted at the end of
+// numeric strings.
+type SuffixMode uint8
+
+// Suffix modes, the zero value rejects all suffixes.
+const (
+
+	// SuffixNone rejects magnitude suffixes.
+	SuffixNone SuffixMode = iota
+
+	// SuffixSI accepts decimal SI prefixes k, M, G, T, P, E, Z and Y along with
+	// the binary IEC prefixes Ki, Mi, Gi, Ti, Pi, Ei, Zi and Yi, so "2.5k" is
+	// 2500 and "1Mi" is 1048576.
+	SuffixSI
+
+	// SuffixBytes is like SuffixSI but the prefix may be followed by a byte
+	// unit, so "10GB" is 10000000000 and "512MiB" is 536870912.
+	SuffixBytes
+
+	// SuffixBytesBinary is like SuffixBytes except the decimal prefixes are
+	// treated as powers of 1024, so "1KB" is 1024.
+	SuffixBytesBinary
+)
+
+// maxScaleExp is the largest exponent which may be scaled by a suffix, it is
+// far beyond the range of any Go number.
+const maxScaleExp = 1000
+
+var siPrefixes = []string{"k", "M", "G", "T", "P", "E", "Z", "Y"}
+
+// prefix returns the number of thousands the suffix of v represents and
+// the length of the suffix, or zeros if v has no prefix.
+func prefix(v string) (exp, n int) {
+	for i, p := range siPrefixes {
+		if strings.HasSuffix(v, p+"i") || (i == 0 && strings.HasSuffix(v, "Ki")) {
+			return i + 1, 2
+		}
+	}
+	for i, p := range siPrefixes {
+		if strings.HasSuffix(v, p) || (i == 0 && strings.HasSuffix(v, "K")) {
+			return i + 1, 1
+		}
+	}
+	return 0, 0
+}
+
+// Scale removes the magnitude suffix from the given numeric string, which is
+// then normalized by the given Locale and multiplied by the magnitude exactly.
+// The result is in the canonical form accepted by the strconv package and is
+// free of exponents when a suffix is present, i.e. "1.5GiB" is "1610612736".
+func (m SuffixMode) Scale(l Locale, s string) (string, error) {
+	if m == SuffixNone {
+		return l.Normalize(s)
+	}
+
+	v := s
+	if m >= SuffixBytes && strings.HasSuffix(v, "B") {
+		v = v[:len(v)-1]
+	}
+
+	var exp10, exp2 int
+	if exp, n := prefix(v); n > 0 {
+		if n == 2 || m == SuffixBytesBinary {
+			exp2 = 10 * exp
+		} else {
+			exp10 = 3 * exp
+		}
+		v = v[:len(v)-n]
+	}
+	v = strings.TrimSpace(v)
+
+	norm, err := l.Normalize(v)
+	if err != nil || (exp10 == 0 && exp2 == 0) {
+		return norm, err
+	}
+	return scaleExact(norm, exp10, exp2)
+}
+
+// scaleExact returns the canonical numeric string s multiplied by
+// 10^exp10 * 2^exp2 without any loss of precision.
+func scaleExact(s string, exp10, exp2 int) (string, error) {
+	r, err := convStrToRat(s)
+	if err != nil {
+		return "", err
+	}
+
+	mul := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp10)), nil)
+	mul.Lsh(mul, uint(exp2))
+	r.Mul(r, new(big.Rat).SetInt(mul))
+	return ratString(r), nil
+}
+
+// ratString returns the exact decimal form of r, which must have a
+// denominator containing only the prime factors 2 and 5.
+func ratString(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	n, _ := ratDigits(r)
+	return r.FloatString(n)
+}
+
+// ratDigits returns the number of fractional digits needed to write r as a
+// decimal number, ok is false if r can not be written exactly.
+func ratDigits(r *big.Rat) (n int, ok bool) {
+	var (
+		d       = new(big.Int).Set(r.Denom())
+		m       = new(big.Int)
+		two     = big.NewInt(2)
+		five    = big.NewInt(5)
+		n2, n5  int
+		zero, q = big.NewInt(0), new(big.Int)
+	)
+	for q.QuoRem(d, two, m); m.Cmp(zero) == 0; q.QuoRem(d, two, m) {
+		d.Set(q)
+		n2++
+	}
+	for q.QuoRem(d, five, m); m.Cmp(zero) == 0; q.QuoRem(d, five, m) {
+		d.Set(q)
+		n5++
+	}
+	if n5 > n2 {
+		n2 = n5
+	}
+	return n2, d.IsInt64() && d.Int64() == 1
+}
@@ -0,0 +1,130 @@
+package refconv
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestSuffixes(t *testing.T) {
+	plain := Locale{Decimal: '.'}
+
+	t.Run("Scale", func(t *testing.T) {
+		tests := []struct {
+			m    SuffixMode
+			l    Locale
+			from string
+			exp  string
+		}{
+			{SuffixNone, Locale{}, "10k", "10k"},
+			{SuffixSI, plain, "12", "12"},
+			{SuffixSI, plain, "2.5k", "2500"},
+			{SuffixSI, plain, "2.5K", "2500"},
+			{SuffixSI, plain, "1M", "1000000"},
+			{SuffixSI, plain, "1.5 G", "1500000000"},
+			{SuffixSI, plain, "-3T", "-3000000000000"},
+			{SuffixSI, plain, "1P", "1000000000000000"},
+			{SuffixSI, plain, "1E", "1000000000000000000"},
+			{SuffixSI, plain, "1Z", "1000000000000000000000"},
+			{SuffixSI, plain, "1Y", "1000000000000000000000000"},
+			{SuffixSI, plain, "1Ki", "1024"},
+			{SuffixSI, plain, "1ki", "1024"},
+			{SuffixSI, plain, "1Mi", "1048576"},
+			{SuffixSI, plain, "0.0001k", "0.1"},
+			{SuffixSI, plain, "0.1Ki", "102.4"},
+			{SuffixSI, plain, "1e3k", "1000000"},
+			{SuffixSI, LocaleDE, "1.234,5k", "1234500"},
+			{SuffixBytes, plain, "10B", "10"},
+			{SuffixBytes, plain, "10GB", "10000000000"},
+			{SuffixBytes, plain, "10 kB", "10000"},
+			{SuffixBytes, plain, "10KB", "10000"},
+			{SuffixBytes, plain, "512MiB", "536870912"},
+			{SuffixBytes, plain, "1.5GiB", "1610612736"},
+			{SuffixBytes, plain, "1.5Gi", "1610612736"},
+			{SuffixBytesBinary, plain, "1KB", "1024"},
+			{SuffixBytesBinary, plain, "1K", "1024"},
+			{SuffixBytesBinary, plain, "2GB", "2147483648"},
+			{SuffixBytesBinary, plain, "1KiB", "1024"},
+		}
+		for _, test := range tests {
+			got, err := test.m.Scale(test.l, test.from)
+			if err != nil {
+				t.Errorf("Scale(%q) unexpected err: %v", test.from, err)
+			} else if got != test.exp {
+				t.Errorf("Scale(%q) exp %q; got %q", test.from, test.exp, got)
+			}
+		}
+	})
+	t.Run("ScaleErrors", func(t *testing.T) {
+		tests := []struct {
+			m    SuffixMode
+			from string
+		}{
+			{SuffixSI, "10GB"},
+			{SuffixSI, "10KiB"},
+			{SuffixSI, "k"},
+			{SuffixSI, "10kk"},
+			{SuffixSI, "10x"},
+			{SuffixSI, "Infk"},
+			{SuffixSI, "1e1001k"},
+			{SuffixSI, "1e-1001k"},
+			{SuffixSI, "1e99999999999999999999k"},
+			{SuffixBytes, "10BB"},
+		}
+		for _, test := range tests {
+			if got, err := test.m.Scale(plain, test.from); err == nil {
+				t.Errorf("Scale(%q) exp err; got %q", test.from, got)
+			}
+		}
+	})
+	t.Run("ratString", func(t *testing.T) {
+		tests := []struct {
+			from *big.Rat
+			exp  string
+		}{
+			{big.NewRat(1, 2), "0.5"},
+			{big.NewRat(1, 4), "0.25"},
+			{big.NewRat(1, 5), "0.2"},
+			{big.NewRat(-3, 8), "-0.375"},
+			{big.NewRat(1, 1000), "0.001"},
+			{big.NewRat(10, 1), "10"},
+		}
+		for _, test := range tests {
+			if got := ratString(test.from); got != test.exp {
+				t.Errorf("ratString(%v) exp %q; got %q", test.from, test.exp, got)
+			}
+		}
+	})
+	t.Run("Conv", func(t *testing.T) {
+		c := Conv{Suffixes: SuffixBytes}
+		if got, err := c.Uint64("512MiB"); err != nil || got != 536870912 {
+			t.Errorf("Uint64 exp 536870912; got %v (%v)", got, err)
+		}
+		if got, err := c.Int64("-2.5k"); err != nil || got != -2500 {
+			t.Errorf("Int64 exp -2500; got %v (%v)", got, err)
+		}
+		if got, err := c.Float64("1.5GB"); err != nil || got != 1.5e9 {
+			t.Errorf("Float64 exp 1.5e9; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64("15EiB"); err != nil || got != 15<<60 {
+			t.Errorf("Uint64 exp 15<<60; got %v (%v)", got, err)
+		}
+		if got, err := c.Uint64("16EiB"); err == nil {
+			t.Errorf("Uint64 exp overflow err; got %v", got)
+		}
+		if got, err := c.Int64("8EiB"); err == nil {
+			t.Errorf("Int64 exp overflow err; got %v", got)
+		}
+		if got, err := c.Int64("-8EiB"); err != nil || got != math.MinInt64 {
+			t.Errorf("Int64 exp MinInt64; got %v (%v)", got, err)
+		}
+		if got, err := c.Int8("1k"); err != nil || got != math.MaxInt8 {
+			t.Errorf("Int8 exp MaxInt8; got %v (%v)", got, err)
+		}
+
+		c = Conv{Suffixes: SuffixSI, Affixes: Affixes{Currency: []string{"$"}}}
+		if got, err := c.Int64("$2.5k"); err != nil || got != 2500 {
+			t.Errorf("Int64 exp 2500; got %v (%v)", got, err)
+		}
+	})
+}
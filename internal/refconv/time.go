@@ -17,45 +17,70 @@ var (
 	typeOfDuration = reflect.TypeOf(time.Duration(0))
 )
 
-func (c Conv) convStrToDuration(v string) (time.Duration, error) {
+func (c *Conv) convStrToDuration(v string) (time.Duration, error) {
+	v, err := c.preprocess(v)
+	if err != nil {
+		return 0, newConvErrReason(v, "time.Duration", err)
+	}
 	if parsed, err := time.ParseDuration(v); err == nil {
+		if c.Trace != nil {
+			c.tracef("parsed %q with time.ParseDuration", v)
+		}
 		return parsed, nil
+	} else if c.Strict {
+		return 0, newConvErrReason(v, "time.Duration", ErrSyntax)
 	}
-	if parsed, err := strconv.ParseInt(v, 10, 0); err == nil {
-		return time.Duration(parsed), nil
-	}
-	if parsed, err := strconv.ParseFloat(v, 64); err == nil {
-		return time.Duration(1e9 * parsed), nil
+	if norm, ok := c.convNumStr(v); ok {
+		if parsed, err := strconv.ParseInt(norm, 10, 0); err == nil {
+			c.tracef("parsed %q as an integer number of nanoseconds", norm)
+			return time.Duration(parsed), nil
+		}
+		if parsed, err := strconv.ParseFloat(norm, 64); err == nil {
+			if d, ok := c.convFloatToDuration(parsed); ok {
+				c.tracef("parsed %q as a float number of seconds", norm)
+				return d, nil
+			}
+		}
 	}
 	return 0, fmt.Errorf("cannot parse %#v (type string) as time.Duration", v)
 }
 
-func (c Conv) convNumToDuration(k reflect.Kind, v reflect.Value) (time.Duration, bool) {
+func (c *Conv) convNumToDuration(k reflect.Kind, v reflect.Value) (time.Duration, bool) {
 	switch {
 	case refutil.IsKindInt(k):
 		return time.Duration(v.Int()), true
 	case refutil.IsKindUint(k):
 		T := v.Uint()
 		if T > math.MaxInt64 {
+			c.tracef("clamped %v to the range of time.Duration", T)
 			T = math.MaxInt64
 		}
 		return time.Duration(T), true
 	case refutil.IsKindFloat(k):
-		T := v.Float()
-		if math.IsNaN(T) || math.IsInf(T, 0) {
-			return 0, true
-		}
-		return time.Duration(1e9 * T), true
+		return c.convFloatToDuration(v.Float())
 	case refutil.IsKindComplex(k):
 		T := v.Complex()
-		if cmplx.IsNaN(T) || cmplx.IsInf(T) {
-			return 0, true
+		if cmplx.IsNaN(T) {
+			return c.convFloatToDuration(math.NaN())
+		}
+		if f, err := c.convComplexToReal(T); err == nil {
+			return c.convFloatToDuration(f)
 		}
-		return time.Duration(1e9 * real(T)), true
 	}
 	return 0, false
 }
 
+func (c *Conv) convFloatToDuration(secs float64) (time.Duration, bool) {
+	secs, err := c.convNonFinite(secs, false)
+	if err != nil {
+		return 0, false
+	}
+	if math.IsNaN(secs) || math.IsInf(secs, 0) {
+		return 0, true
+	}
+	return convSecsToDuration(secs), true
+}
+
 type durationConverter interface {
 	Duration() (time.Duration, error)
 }
@@ -63,15 +88,19 @@ type durationConverter interface {
 // Duration attempts to convert the given value to time.Duration, returns the
 // zero value and an error on failure.
 func (c Conv) Duration(from interface{}) (time.Duration, error) {
+	if empty, err := c.convEmpty(from, "time.Duration"); empty {
+		return 0, err
+	}
 	if T, ok := from.(string); ok {
 		return c.convStrToDuration(T)
 	} else if T, ok := from.(time.Duration); ok {
 		return T, nil
-	} else if c, ok := from.(durationConverter); ok {
-		return c.Duration()
+	} else if T, ok := from.(durationConverter); ok {
+		c.traceHook(from, "Duration")
+		return T.Duration()
 	}
 
-	value := refutil.IndirectVal(reflect.ValueOf(from))
+	value := c.indirect(from)
 	kind := value.Kind()
 	switch {
 	case reflect.String == kind:
@@ -80,8 +109,14 @@ func (c Conv) Duration(from interface{}) (time.Duration, error) {
 		if parsed, ok := c.convNumToDuration(kind, value); ok {
 			return parsed, nil
 		}
+	case refutil.IsKindLength(kind) && c.Length == LengthUnwrap:
+		elem, err := c.convUnwrap(value)
+		if err != nil {
+			return 0, newConvErrReason(from, "time.Duration", err)
+		}
+		return c.Duration(elem)
 	}
-	return 0, newConvErr(from, "time.Duration")
+	return 0, c.newUnsupportedErr(from, "time.Duration")
 }
 
 type timeConverter interface {
@@ -91,34 +126,64 @@ type timeConverter interface {
 // Time attempts to convert the given value to time.Time, returns the zero value
 // of time.Time and an error on failure.
 func (c Conv) Time(from interface{}) (time.Time, error) {
+	if empty, err := c.convEmpty(from, "time.Time"); empty {
+		return emptyTime, err
+	}
 	if T, ok := from.(time.Time); ok {
 		return T, nil
 	} else if T, ok := from.(*time.Time); ok {
 		return *T, nil
-	} else if c, ok := from.(timeConverter); ok {
-		return c.Time()
+	} else if T, ok := from.(timeConverter); ok {
+		c.traceHook(from, "Time")
+		return T.Time()
 	}
 
-	value := reflect.ValueOf(refutil.Indirect(from))
+	value := c.indirect(from)
 	kind := value.Kind()
 	switch {
 	case reflect.String == kind:
-		if T, ok := convStringToTime(value.String()); ok {
+		v, err := c.preprocess(value.String())
+		if err != nil {
+			return emptyTime, newConvErrReason(from, "time.Time", err)
+		}
+		if c.Strict {
+			T, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				c.tracef("%q did not match the layout %q", v, time.RFC3339Nano)
+				return emptyTime, newConvErrReason(from, "time.Time", ErrSyntax)
+			}
+			c.tracef("parsed %q with the layout %q", v, time.RFC3339Nano)
+			return T, nil
+		}
+		if T, layout, ok := convStringToTime(v); ok {
+			c.tracef("parsed %q with the layout %q", v, layout)
 			return T, nil
 		}
+		c.tracef("%q did not match any of the %d time layouts tried", v, len(formats))
 	case reflect.Struct == kind:
 		if value.Type().ConvertibleTo(typeOfTime) {
 			valueConv := value.Convert(typeOfTime)
 			if valueConv.CanInterface() {
+				c.tracef("converted %v to time.Time", value.Type())
 				return valueConv.Interface().(time.Time), nil
 			}
 		}
+		if c.Strict {
+			return emptyTime, newConvErrReason(from, "time.Time", ErrUnsupported)
+		}
 		field := value.FieldByName("Time")
 		if field.IsValid() && field.CanInterface() {
+			c.tracef("used the Time field of %v", value.Type())
 			return c.Time(field.Interface())
 		}
+	case refutil.IsKindLength(kind) && c.Length == LengthUnwrap:
+		elem, err := c.convUnwrap(value)
+		if err != nil {
+			return emptyTime, newConvErrReason(from, "time.Time", err)
+		}
+		return c.Time(elem)
 	}
-	return emptyTime, newConvErr(from, "time.Time")
+	return emptyTime, c.newUnsupportedErr(from, "time.Time")
 }
 
 type formatInfo struct {
@@ -175,9 +240,9 @@ var formats = []formatInfo{
 // I can find a decent lexer or polish up my "timey" Go lib. I am using the
 // table of dates politely released into public domain by github.com/tomarus:
 //   https://github.com/tomarus/parsedate/blob/master/parsedate.go
-func convStringToTime(s string) (time.Time, bool) {
+func convStringToTime(s string) (time.Time, string, bool) {
 	if len(s) == 0 {
-		return time.Time{}, false
+		return time.Time{}, "", false
 	}
 	for _, f := range formats {
 		_, err := time.Parse(f.format, s)
@@ -186,8 +251,8 @@ func convStringToTime(s string) (time.Time, bool) {
 		}
 		if t, err := time.Parse(
 			f.format+f.needed, s+time.Now().Format(f.needed)); err == nil {
-			return t, true
+			return t, f.format, true
 		}
 	}
-	return time.Time{}, false
+	return time.Time{}, "", false
 }
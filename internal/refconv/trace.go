@@ -0,0 +1,58 @@
+package refconv
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/cstockton/go-conv/internal/refutil"
+)
+
+// Explain performs the same conversion as Infer, returning a description of
+// each step taken such as the interface methods called, pointers followed,
+// fallback parsers used, the time layouts tried and any rounding or clamping
+// applied. The steps are returned even when conversion fails, if the Trace
+// func of this Conv is set it is also called for each of them.
+//
+// Example:
+//
+//   var into int
+//   steps, err := c.Explain(&into, `T`)
+//   // steps[2] -> "T" is not a number, parsed it as the bool true
+func (c Conv) Explain(into, from interface{}) ([]string, error) {
+	var steps []string
+	trace := c.Trace
+	c.Trace = func(step string) {
+		steps = append(steps, step)
+		if trace != nil {
+			trace(step)
+		}
+	}
+	err := c.Infer(into, from)
+	return steps, err
+}
+
+// tracef reports a step of a conversion to the Trace func of this Conv when it
+// is set. Frequently taken paths check Trace before calling tracef so their
+// arguments are not allocated when tracing is disabled.
+func (c *Conv) tracef(format string, args ...interface{}) {
+	if c.Trace != nil {
+		c.Trace(fmt.Sprintf(format, args...))
+	}
+}
+
+// traceHook reports that the conversion method named method of from was used.
+func (c *Conv) traceHook(from interface{}, method string) {
+	if c.Trace != nil {
+		c.tracef("called the %v method of %T", method, from)
+	}
+}
+
+// indirect returns the value of from after following any pointers it holds,
+// reporting the type reached when it differs from that of from.
+func (c *Conv) indirect(from interface{}) reflect.Value {
+	value := refutil.IndirectVal(reflect.ValueOf(from))
+	if c.Trace != nil && value.IsValid() && value.Type() != reflect.TypeOf(from) {
+		c.tracef("followed %T to %v", from, value.Type())
+	}
+	return value
+}
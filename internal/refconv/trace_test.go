@@ -0,0 +1,131 @@
+package refconv
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type traceHook struct{}
+
+func (traceHook) Int64() (int64, error) { return 5, nil }
+
+func TestExplain(t *testing.T) {
+	s := "300"
+	type T struct{ Time string }
+	tests := []struct {
+		c    Conv
+		into interface{}
+		from interface{}
+		exp  []string
+	}{
+		{Conv{}, new(int), "T", []string{
+			`converting "T" (type string) to int`,
+			`parsing "T" as a number`,
+			`"T" is not a number, parsed it as the bool true`}},
+		{Conv{}, new(int8), &s, []string{
+			`followed *string to string`,
+			`parsing "300" as a number`,
+			`clamped 300 to the range of int8`}},
+		{Conv{}, new(uint), -2.7, []string{
+			`rounded -2.7 to -2`,
+			`clamped -2 to the range of uint64`}},
+		{Conv{}, new(float32), 1e300, []string{
+			`clamped 1e+300 to the range of float32`}},
+		{Conv{}, new(int), complex(1.5, 2), []string{
+			`dropped the imaginary part of (1.5+2i)`,
+			`rounded 1.5 to 1`}},
+		{Conv{}, new(int), []int{1, 2}, []string{
+			`converted []int to its length 2`}},
+		{Conv{Length: LengthUnwrap}, new(int), []string{"1.5"}, []string{
+			`unwrapped the only element of []string`,
+			`rounded away the fraction .5`}},
+		{Conv{NonFinite: NonFiniteSaturate}, new(int), "-Inf", []string{
+			`saturated -Inf to -1.7976931348623157e+308`,
+			`clamped -1.7976931348623157e+308 to the range of int64`}},
+		{Conv{}, new(int64), traceHook{}, []string{
+			`called the Int64 method of refconv.traceHook`}},
+		{Conv{}, new(time.Duration), "1.5", []string{
+			`parsed "1.5" as a float number of seconds`}},
+		{Conv{}, new(time.Duration), "1m", []string{
+			`parsed "1m" with time.ParseDuration`}},
+		{Conv{}, new(time.Time), "Mon, 02 Jan 2006 15:04:05 -0700", []string{
+			`with the layout "Mon, 02 Jan 2006 15:04:05 -0700"`}},
+		{Conv{}, new(time.Time), T{"2006-01-02T15:04:05Z"}, []string{
+			`used the Time field of refconv.T`,
+			`with the layout "2006-01-02T15:04:05.999999999Z07:00"`}},
+		{Conv{Locale: LocaleDE}, new(float64), "1.234,5", []string{
+			`normalized "1.234,5" to "1234.5"`,
+			`parsed "1234.5" as a float`}},
+		{Conv{Empty: EmptyZero, Preprocess: []Preprocessor{TrimSpace}},
+			new(int), " null ", []string{
+				`preprocessed " null " to "null"`,
+				`" null " is empty, used the zero value of int64`}},
+	}
+	for _, test := range tests {
+		steps, err := test.c.Explain(test.into, test.from)
+		if err != nil {
+			t.Errorf("Explain(%T, %#v) exp nil err; got %v", test.into, test.from, err)
+			continue
+		}
+		joined := strings.Join(steps, "\n")
+		for _, exp := range test.exp {
+			if !strings.Contains(joined, exp) {
+				t.Errorf("Explain(%T, %#v) exp step %q; got:\n%v",
+					test.into, test.from, exp, joined)
+			}
+		}
+	}
+
+	t.Run("Failure", func(t *testing.T) {
+		var c Conv
+		steps, err := c.Explain(new(int), "abc")
+		if err == nil {
+			t.Error("Explain exp non-nil err")
+		}
+		exp := []string{
+			`converting "abc" (type string) to int`, `parsing "abc" as a number`}
+		if !reflect.DeepEqual(steps, exp) {
+			t.Errorf("Explain exp %q; got %q", exp, steps)
+		}
+
+		steps, err = c.Explain(new(time.Time), "abc")
+		if err == nil {
+			t.Error("Explain exp non-nil err")
+		}
+		exp = []string{`converting "abc" (type string) to time.Time`, fmt.Sprintf(
+			`"abc" did not match any of the %d time layouts tried`, len(formats))}
+		if !reflect.DeepEqual(steps, exp) {
+			t.Errorf("Explain exp %q; got %q", exp, steps)
+		}
+
+		c.Strict = true
+		steps, _ = c.Explain(new(time.Time), "abc")
+		if last := steps[len(steps)-1]; !strings.Contains(last, "did not match the layout") {
+			t.Errorf("Explain exp layout step; got %q", steps)
+		}
+	})
+	t.Run("Trace", func(t *testing.T) {
+		var got []string
+		c := Conv{Trace: func(step string) { got = append(got, step) }}
+		if _, err := c.Int8("300"); err != nil {
+			t.Fatal(err)
+		}
+		exp := []string{
+			`parsing "300" as a number`, `clamped 300 to the range of int8`}
+		if !reflect.DeepEqual(got, exp) {
+			t.Errorf("Trace exp %q; got %q", exp, got)
+		}
+
+		got = nil
+		steps, err := c.Explain(new(int8), "300")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, steps) {
+			t.Errorf("Explain exp Trace to be called with %q; got %q", steps, got)
+		}
+	})
+}
@@ -1,7 +1,7 @@
 package refconv
 
 import (
-	"fmt"
+	"errors"
 	"math"
 	"reflect"
 	"strconv"
@@ -9,20 +9,58 @@ import (
 	"github.com/cstockton/go-conv/internal/refutil"
 )
 
-func (c Conv) convStrToUint64(v string) (uint64, error) {
-	if parsed, err := strconv.ParseUint(v, 10, 0); err == nil {
-		return parsed, nil
+func (c *Conv) convStrToUint64(v string) (uint64, error) {
+	v, err := c.preprocess(v)
+	if err != nil {
+		return 0, newConvErrReason(v, "uint64", err)
+	}
+	if norm, ok := c.convNumStr(v); ok {
+		if c.Trace != nil {
+			c.tracef("parsing %q as a number", norm)
+		}
+		if parsed, err := strconv.ParseUint(norm, 10, 64); err == nil {
+			return parsed, nil
+		} else if c.Strict && !isIntSyntax(norm, false) {
+			return 0, newConvErrReason(v, "uint64", ErrSyntax)
+		}
+		mag, neg, err := c.parseExact(norm)
+		if err == nil {
+			if neg {
+				c.tracef("clamped %q to the range of uint64", norm)
+				return 0, nil
+			}
+			return mag, nil
+		}
+		if err == errNotFinite {
+			f, _ := parseNonFinite(norm)
+			return c.convFloatToUint64(v, f)
+		} else if errors.Is(err, strconv.ErrRange) {
+			if neg {
+				c.tracef("clamped %q to the range of uint64", norm)
+				return 0, nil
+			}
+			return 0, newConvErrReason(v, "uint64", errRange)
+		} else if err != strconv.ErrSyntax {
+			return 0, newConvErrReason(v, "uint64", err)
+		}
+
+		// Forms such as "1_000" and "0x1p4" are not canonical but have always
+		// been accepted by ParseFloat.
+		if parsed, err := strconv.ParseFloat(norm, 64); err == nil {
+			return c.convFloatToUint64(v, parsed)
+		}
 	}
-	if parsed, err := strconv.ParseFloat(v, 64); err == nil {
-		return uint64(math.Max(0, parsed)), nil
+	if c.Strict {
+		return 0, newConvErrReason(v, "uint64", ErrSyntax)
 	}
 	if parsed, err := c.convStrToBool(v); err == nil {
+		c.tracef("%q is not a number, parsed it as the bool %v", v, parsed)
 		if parsed {
 			return 1, nil
 		}
 		return 0, nil
 	}
-	return 0, fmt.Errorf("cannot convert %#v (type string) to uint64", v)
+	return 0, newConvErr(v, "uint64")
 }
 
 type uintConverter interface {
@@ -32,16 +70,20 @@ type uintConverter interface {
 // Uint64 attempts to convert the given value to uint64, returns the zero value
 // and an error on failure.
 func (c Conv) Uint64(from interface{}) (uint64, error) {
+	if empty, err := c.convEmpty(from, "uint64"); empty {
+		return 0, err
+	}
 	if T, ok := from.(string); ok {
 		return c.convStrToUint64(T)
 	} else if T, ok := from.(uint64); ok {
 		return T, nil
 	}
-	if c, ok := from.(uintConverter); ok {
-		return c.Uint64()
+	if T, ok := from.(uintConverter); ok {
+		c.traceHook(from, "Uint64")
+		return T.Uint64()
 	}
 
-	value := refutil.IndirectVal(reflect.ValueOf(from))
+	value := c.indirect(from)
 	kind := value.Kind()
 	switch {
 	case reflect.String == kind:
@@ -50,24 +92,53 @@ func (c Conv) Uint64(from interface{}) (uint64, error) {
 		return value.Uint(), nil
 	case refutil.IsKindInt(kind):
 		val := value.Int()
-		if val < 0 {
+		if val < 0 && c.Strict {
+			return 0, newRangeErr(from, "uint64")
+		} else if val < 0 {
+			c.tracef("clamped %v to the range of uint64", val)
 			val = 0
 		}
 		return uint64(val), nil
 	case refutil.IsKindFloat(kind):
-		return uint64(math.Max(0, value.Float())), nil
+		return c.convFloatToUint64(from, value.Float())
 	case refutil.IsKindComplex(kind):
-		return uint64(math.Max(0, real(value.Complex()))), nil
+		f, err := c.convComplexToReal(value.Complex())
+		if err != nil {
+			return 0, newConvErrReason(from, "uint64", err)
+		}
+		return c.convFloatToUint64(from, f)
 	case reflect.Bool == kind:
+		if c.Strict {
+			return 0, newConvErrReason(from, "uint64", ErrUnsupported)
+		}
 		if value.Bool() {
 			return 1, nil
 		}
 		return 0, nil
+	case c.Binary != BinaryNone && isKindBytes(value):
+		v, err := c.convBytesToUint64(c.convValToBytes(value))
+		if err != nil {
+			return 0, newConvErrReason(from, "uint64", err)
+		}
+		return v, nil
 	case refutil.IsKindLength(kind):
-		return uint64(value.Len()), nil
+		if c.Length == LengthDefault && !c.Strict {
+			c.tracef("converted %v to its length %d", value.Type(), value.Len())
+			return uint64(value.Len()), nil
+		}
+		elem, err := c.convUnwrap(value)
+		if err != nil {
+			return 0, newConvErrReason(from, "uint64", err)
+		}
+		return c.Uint64(elem)
 	}
 
-	return 0, newConvErr(from, "uint64")
+	if r, inf, ok, err := convBigToRat(from); err != nil {
+		return 0, newConvErrReason(from, "uint64", err)
+	} else if ok {
+		return c.convRatToUint64(from, r, inf)
+	}
+	return 0, c.newUnsupportedErr(from, "uint64")
 }
 
 // Uint attempts to convert the given value to uint, returns the zero value and
@@ -79,9 +150,12 @@ func (c Conv) Uint(from interface{}) (uint, error) {
 
 	to64, err := c.Uint64(from)
 	if err != nil {
-		return 0, newConvErr(from, "uint")
+		return 0, newConvErrFrom(from, "uint", err)
 	}
-	if to64 > mathMaxUint {
+	if to64 > mathMaxUint && c.isRangeChecked(from) {
+		return 0, newRangeErr(from, "uint")
+	} else if to64 > mathMaxUint {
+		c.tracef("clamped %v to the range of uint", to64)
 		to64 = mathMaxUint // only possible on 32bit arch
 	}
 	return uint(to64), nil
@@ -96,9 +170,12 @@ func (c Conv) Uint8(from interface{}) (uint8, error) {
 
 	to64, err := c.Uint64(from)
 	if err != nil {
-		return 0, newConvErr(from, "uint8")
+		return 0, newConvErrFrom(from, "uint8", err)
 	}
-	if to64 > math.MaxUint8 {
+	if to64 > math.MaxUint8 && c.isRangeChecked(from) {
+		return 0, newRangeErr(from, "uint8")
+	} else if to64 > math.MaxUint8 {
+		c.tracef("clamped %v to the range of uint8", to64)
 		to64 = math.MaxUint8
 	}
 	return uint8(to64), nil
@@ -113,9 +190,12 @@ func (c Conv) Uint16(from interface{}) (uint16, error) {
 
 	to64, err := c.Uint64(from)
 	if err != nil {
-		return 0, newConvErr(from, "uint16")
+		return 0, newConvErrFrom(from, "uint16", err)
 	}
-	if to64 > math.MaxUint16 {
+	if to64 > math.MaxUint16 && c.isRangeChecked(from) {
+		return 0, newRangeErr(from, "uint16")
+	} else if to64 > math.MaxUint16 {
+		c.tracef("clamped %v to the range of uint16", to64)
 		to64 = math.MaxUint16
 	}
 	return uint16(to64), nil
@@ -130,9 +210,12 @@ func (c Conv) Uint32(from interface{}) (uint32, error) {
 
 	to64, err := c.Uint64(from)
 	if err != nil {
-		return 0, newConvErr(from, "uint32")
+		return 0, newConvErrFrom(from, "uint32", err)
 	}
-	if to64 > math.MaxUint32 {
+	if to64 > math.MaxUint32 && c.isRangeChecked(from) {
+		return 0, newRangeErr(from, "uint32")
+	} else if to64 > math.MaxUint32 {
+		c.tracef("clamped %v to the range of uint32", to64)
 		to64 = math.MaxUint32
 	}
 	return uint32(to64), nil
@@ -0,0 +1,217 @@
+package refconv
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/cstockton/go-conv/internal/refutil"
+)
+
+// Value wraps a dynamic value such as one decoded from JSON, providing a method
+// for each conversion which records the first error that occurs rather than
+// returning it. Values returned by Index, Key, Slice and Map share the errors
+// of the Value they were derived from, allowing a group of conversions to be
+// checked once by calling Err.
+//
+// The zero Value holds nil and has nowhere to record errors, so Err always
+// returns an error for it.
+type Value struct {
+	c    Conv
+	from interface{}
+	fail error
+	err  *error
+}
+
+var errNoValue = errors.New("Value was not created by ValueOf")
+
+// Value returns a Value wrapping from which performs conversions using c.
+func (c Conv) Value(from interface{}) Value {
+	return Value{c: c, from: from, err: new(error)}
+}
+
+// derive returns a Value wrapping from which shares the errors of v.
+func (v Value) derive(from interface{}, fail error) Value {
+	return Value{c: v.c, from: from, fail: fail, err: v.err}
+}
+
+// ok records the failure of the Index or Key call which derived v and returns
+// false, or returns true if there was none.
+func (v Value) ok() bool {
+	if v.fail != nil {
+		v.record(v.fail)
+		return false
+	}
+	return true
+}
+
+// record keeps err if it is the first error to occur.
+func (v Value) record(err error) {
+	if err != nil && v.err != nil && *v.err == nil {
+		*v.err = err
+	}
+}
+
+// Err returns the first error which occurred while converting v or any Value
+// derived from it.
+func (v Value) Err() error {
+	if v.err == nil {
+		return errNoValue
+	}
+	return *v.err
+}
+
+// Interface returns the value wrapped by v.
+func (v Value) Interface() interface{} {
+	return v.from
+}
+
+// IsNil returns true if the value wrapped by v is nil, a nil pointer or a nil
+// map, slice, channel, func or interface.
+func (v Value) IsNil() bool {
+	value := refutil.IndirectVal(reflect.ValueOf(v.from))
+	if !value.IsValid() {
+		return true
+	}
+	return refutil.IsKindNillable(value.Kind()) && value.IsNil()
+}
+
+// Index returns the element at index i of an array or slice. An error is
+// recorded when the returned Value is converted if v does not hold one or i is
+// out of range.
+func (v Value) Index(i int) Value {
+	if v.fail != nil {
+		return v
+	}
+	from, err := v.c.lookupIndex(v.from, i)
+	return v.derive(from, err)
+}
+
+// Key returns the element of a map stored under key, or the exported field
+// of a struct with the name key. Keys are converted to the key type of the
+// map, so "1" may be used for a map[int]string. An error is recorded when the
+// returned Value is converted if v does not hold one or key is not found.
+func (v Value) Key(key interface{}) Value {
+	if v.fail != nil {
+		return v
+	}
+	from, err := v.c.lookupKey(v.from, key)
+	return v.derive(from, err)
+}
+
+// Get returns the value found by following path from the value wrapped by v,
+// see Conv.Get for the syntax of path. An error is recorded when the returned
+// Value is converted if path is invalid or not found.
+func (v Value) Get(path string) Value {
+	if v.fail != nil {
+		return v
+	}
+	from, err := v.c.Get(v.from, path)
+	return v.derive(from, err)
+}
+
+// Slice returns each element of an array or slice, an error is recorded if v
+// does not hold one.
+func (v Value) Slice() []Value {
+	if !v.ok() {
+		return nil
+	}
+
+	value := refutil.IndirectVal(reflect.ValueOf(v.from))
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice:
+		res := make([]Value, value.Len())
+		for i := range res {
+			var from interface{}
+			if elem := value.Index(i); elem.CanInterface() {
+				from = elem.Interface()
+			}
+			res[i] = v.derive(from, nil)
+		}
+		return res
+	}
+	v.record(newConvErr(v.from, "[]Value"))
+	return nil
+}
+
+// Map returns each element of a map keyed by the String conversion of its key,
+// an error is recorded if v does not hold a map or a key can not be converted.
+func (v Value) Map() map[string]Value {
+	if !v.ok() {
+		return nil
+	}
+
+	value := refutil.IndirectVal(reflect.ValueOf(v.from))
+	if value.Kind() != reflect.Map {
+		v.record(newConvErr(v.from, "map[string]Value"))
+		return nil
+	}
+
+	res := make(map[string]Value, value.Len())
+	iter := value.MapRange()
+	for iter.Next() {
+		k, elem := iter.Key(), iter.Value()
+		if !k.CanInterface() || !elem.CanInterface() {
+			continue
+		}
+		key, err := v.c.String(k.Interface())
+		if err != nil {
+			v.record(newConvErrReason(v.from, "map[string]Value", err))
+			return nil
+		}
+		res[key] = v.derive(elem.Interface(), nil)
+	}
+	return res
+}
+
+// lookupIndex returns the element at index i of the array or slice from, or
+// the element stored under the key i of a map.
+func (c *Conv) lookupIndex(from interface{}, i int) (interface{}, error) {
+	value := refutil.IndirectVal(reflect.ValueOf(from))
+	switch value.Kind() {
+	case reflect.Map:
+		return c.lookupKey(from, i)
+	case reflect.Array, reflect.Slice:
+		if i < 0 || i >= value.Len() {
+			return nil, fmt.Errorf(
+				"index %d out of range for %#v (type %[2]T)", i, from)
+		}
+		if elem := value.Index(i); elem.CanInterface() {
+			return elem.Interface(), nil
+		}
+	}
+	return nil, fmt.Errorf("cannot index %#v (type %[1]T)", from)
+}
+
+// lookupKey returns the element of the map from stored under key, or the
+// exported field of the struct from with the name key.
+func (c *Conv) lookupKey(from, key interface{}) (interface{}, error) {
+	value := refutil.IndirectVal(reflect.ValueOf(from))
+	switch value.Kind() {
+	case reflect.Map:
+		// Keys already assignable, such as any key of the map[interface{}]
+		// interface{} produced by YAML decoders, are used as is.
+		k := reflect.ValueOf(key)
+		if !k.IsValid() || !k.Type().AssignableTo(value.Type().Key()) {
+			k = reflect.New(value.Type().Key()).Elem()
+			if err := c.inferSet(k, key); err != nil {
+				return nil, err
+			}
+		}
+		if elem := value.MapIndex(k); elem.IsValid() && elem.CanInterface() {
+			return elem.Interface(), nil
+		}
+	case reflect.Struct:
+		name, err := c.String(key)
+		if err != nil {
+			return nil, err
+		}
+		if field := value.FieldByName(name); field.IsValid() && field.CanInterface() {
+			return field.Interface(), nil
+		}
+	default:
+		return nil, fmt.Errorf("cannot find key %#v in %#v (type %[2]T)",
+			key, from)
+	}
+	return nil, fmt.Errorf("key %#v not found in %#v (type %[2]T)", key, from)
+}
@@ -0,0 +1,135 @@
+package refconv
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValue(t *testing.T) {
+	var c Conv
+	data := map[string]interface{}{
+		"int":   "42",
+		"float": 1.5,
+		"dur":   "1m",
+		"time":  "2006-01-02T15:04:05Z",
+		"nil":   nil,
+		"list":  []interface{}{"1", 2, "three"},
+		"ids":   map[int]string{1: "one"},
+		"obj":   struct{ Name string }{"go"},
+		"tags":  map[string]interface{}{"a": "1", "b": "2"},
+		"yaml":  map[interface{}]interface{}{"k": "7", 1: "one"},
+	}
+
+	t.Run("Convert", func(t *testing.T) {
+		v := c.Value(data)
+		if got := v.Key("int").Int(); got != 42 {
+			t.Errorf("Int exp 42; got %v", got)
+		}
+		if got := v.Key("float").Float64(); got != 1.5 {
+			t.Errorf("Float64 exp 1.5; got %v", got)
+		}
+		if got := v.Key("dur").Duration(); got != time.Minute {
+			t.Errorf("Duration exp 1m; got %v", got)
+		}
+		exp := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+		if got := v.Key("time").Time(); !got.Equal(exp) {
+			t.Errorf("Time exp %v; got %v", exp, got)
+		}
+		if got := v.Key("list").Index(1).Int64(); got != 2 {
+			t.Errorf("Int64 exp 2; got %v", got)
+		}
+		if got := v.Key("ids").Key("1").String(); got != "one" {
+			t.Errorf("String exp one; got %v", got)
+		}
+		if got := v.Key("yaml").Key("k").Int(); got != 7 {
+			t.Errorf("Int exp 7; got %v", got)
+		}
+		if got := v.Key("yaml").Key(1).String(); got != "one" {
+			t.Errorf("String exp one; got %v", got)
+		}
+		if got := v.Key("obj").Key("Name").String(); got != "go" {
+			t.Errorf("String exp go; got %v", got)
+		}
+		if got := v.Key("missing").IntOr(8080); got != 8080 {
+			t.Errorf("IntOr exp 8080; got %v", got)
+		}
+		if got := v.Key("list").Index(2).IntOr(3); got != 3 {
+			t.Errorf("IntOr exp 3; got %v", got)
+		}
+		if err := v.Err(); err != nil {
+			t.Errorf("Err exp nil; got %v", err)
+		}
+	})
+	t.Run("Collections", func(t *testing.T) {
+		v := c.Value(data)
+		list := v.Key("list").Slice()
+		if len(list) != 3 || list[0].Int() != 1 || list[2].String() != "three" {
+			t.Errorf("Slice exp [1 2 three]; got %v", list)
+		}
+		tags := v.Key("tags").Map()
+		if len(tags) != 2 || tags["a"].Int() != 1 || tags["b"].Int() != 2 {
+			t.Errorf("Map exp map[a:1 b:2]; got %v", tags)
+		}
+		ids := v.Key("ids").Map()
+		if len(ids) != 1 || ids["1"].String() != "one" {
+			t.Errorf("Map exp map[1:one]; got %v", ids)
+		}
+		if err := v.Err(); err != nil {
+			t.Errorf("Err exp nil; got %v", err)
+		}
+	})
+	t.Run("IsNil", func(t *testing.T) {
+		v := c.Value(data)
+		var nilMap map[string]int
+		for _, from := range []Value{v.Key("nil"), c.Value(nil), c.Value(nilMap),
+			c.Value((*int)(nil))} {
+			if !from.IsNil() {
+				t.Errorf("IsNil(%#v) exp true", from.Interface())
+			}
+		}
+		if v.Key("int").IsNil() || v.IsNil() {
+			t.Error("IsNil exp false")
+		}
+	})
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			fn  func(v Value)
+			exp string
+		}{
+			{func(v Value) { v.Key("list").Index(2).Int() }, `"three"`},
+			{func(v Value) { v.Key("list").Index(3).Int() }, `index 3 out of range`},
+			{func(v Value) { v.Key("float").Index(0).Int() }, `cannot index 1.5`},
+			{func(v Value) { v.Key("int").Index(0).Int() }, `cannot index "42"`},
+			{func(v Value) { v.Key("missing").Int() }, `key "missing" not found`},
+			{func(v Value) { _ = v.Key("ids").Key("x").String() }, `"x"`},
+			{func(v Value) { v.Key("obj").Key("Age").Int() }, `key "Age" not found`},
+			{func(v Value) { v.Key("int").Key("a").Int() }, `cannot find key "a"`},
+			{func(v Value) { v.Key("int").Slice() }, `to []Value`},
+			{func(v Value) { v.Key("list").Map() }, `to map[string]Value`},
+			{func(v Value) { v.Key("missing").Key("a").Index(1).Bool() }, `"missing"`},
+		}
+		for _, test := range tests {
+			v := c.Value(data)
+			test.fn(v)
+			if err := v.Err(); err == nil || !strings.Contains(err.Error(), test.exp) {
+				t.Errorf("Err exp %q; got %v", test.exp, err)
+			}
+		}
+
+		v := c.Value(data)
+		v.Key("missing").Int()
+		v.Key("list").Index(9).Int()
+		if err := v.Err(); err == nil || !strings.Contains(err.Error(), "missing") {
+			t.Errorf("Err exp first error; got %v", err)
+		}
+		var zero Value
+		zero.Int()
+		if err := zero.Err(); err != errNoValue {
+			t.Errorf("Err exp %v; got %v", errNoValue, err)
+		}
+		if got := (Value{}).IntOr(5); got != 5 {
+			t.Errorf("IntOr exp 5; got %v", got)
+		}
+	})
+}
@@ -0,0 +1,424 @@
+// Code generated by go run gen.go. DO NOT EDIT.
+
+package refconv
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/cstockton/go-conv/decimal"
+)
+
+// BigFloat converts the value wrapped by v, recording the first error.
+func (v Value) BigFloat() (to *big.Float) {
+	if v.ok() {
+		var err error
+		to, err = v.c.BigFloat(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// BigFloatOr is like BigFloat but returns def without recording errors.
+func (v Value) BigFloatOr(def *big.Float) *big.Float {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.BigFloatOr(v.from, def)
+}
+
+// BigInt converts the value wrapped by v, recording the first error.
+func (v Value) BigInt() (to *big.Int) {
+	if v.ok() {
+		var err error
+		to, err = v.c.BigInt(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// BigIntOr is like BigInt but returns def without recording errors.
+func (v Value) BigIntOr(def *big.Int) *big.Int {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.BigIntOr(v.from, def)
+}
+
+// BigRat converts the value wrapped by v, recording the first error.
+func (v Value) BigRat() (to *big.Rat) {
+	if v.ok() {
+		var err error
+		to, err = v.c.BigRat(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// BigRatOr is like BigRat but returns def without recording errors.
+func (v Value) BigRatOr(def *big.Rat) *big.Rat {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.BigRatOr(v.from, def)
+}
+
+// Bool converts the value wrapped by v, recording the first error.
+func (v Value) Bool() (to bool) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Bool(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// BoolOr is like Bool but returns def without recording errors.
+func (v Value) BoolOr(def bool) bool {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.BoolOr(v.from, def)
+}
+
+// Bytes converts the value wrapped by v, recording the first error.
+func (v Value) Bytes() (to []byte) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Bytes(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// BytesOr is like Bytes but returns def without recording errors.
+func (v Value) BytesOr(def []byte) []byte {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.BytesOr(v.from, def)
+}
+
+// Complex64 converts the value wrapped by v, recording the first error.
+func (v Value) Complex64() (to complex64) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Complex64(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// Complex64Or is like Complex64 but returns def without recording errors.
+func (v Value) Complex64Or(def complex64) complex64 {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.Complex64Or(v.from, def)
+}
+
+// Complex128 converts the value wrapped by v, recording the first error.
+func (v Value) Complex128() (to complex128) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Complex128(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// Complex128Or is like Complex128 but returns def without recording errors.
+func (v Value) Complex128Or(def complex128) complex128 {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.Complex128Or(v.from, def)
+}
+
+// Decimal converts the value wrapped by v, recording the first error.
+func (v Value) Decimal() (to decimal.Decimal) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Decimal(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// DecimalOr is like Decimal but returns def without recording errors.
+func (v Value) DecimalOr(def decimal.Decimal) decimal.Decimal {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.DecimalOr(v.from, def)
+}
+
+// Duration converts the value wrapped by v, recording the first error.
+func (v Value) Duration() (to time.Duration) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Duration(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// DurationOr is like Duration but returns def without recording errors.
+func (v Value) DurationOr(def time.Duration) time.Duration {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.DurationOr(v.from, def)
+}
+
+// Float32 converts the value wrapped by v, recording the first error.
+func (v Value) Float32() (to float32) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Float32(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// Float32Or is like Float32 but returns def without recording errors.
+func (v Value) Float32Or(def float32) float32 {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.Float32Or(v.from, def)
+}
+
+// Float64 converts the value wrapped by v, recording the first error.
+func (v Value) Float64() (to float64) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Float64(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// Float64Or is like Float64 but returns def without recording errors.
+func (v Value) Float64Or(def float64) float64 {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.Float64Or(v.from, def)
+}
+
+// Int converts the value wrapped by v, recording the first error.
+func (v Value) Int() (to int) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Int(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// IntOr is like Int but returns def without recording errors.
+func (v Value) IntOr(def int) int {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.IntOr(v.from, def)
+}
+
+// Int8 converts the value wrapped by v, recording the first error.
+func (v Value) Int8() (to int8) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Int8(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// Int8Or is like Int8 but returns def without recording errors.
+func (v Value) Int8Or(def int8) int8 {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.Int8Or(v.from, def)
+}
+
+// Int16 converts the value wrapped by v, recording the first error.
+func (v Value) Int16() (to int16) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Int16(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// Int16Or is like Int16 but returns def without recording errors.
+func (v Value) Int16Or(def int16) int16 {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.Int16Or(v.from, def)
+}
+
+// Int32 converts the value wrapped by v, recording the first error.
+func (v Value) Int32() (to int32) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Int32(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// Int32Or is like Int32 but returns def without recording errors.
+func (v Value) Int32Or(def int32) int32 {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.Int32Or(v.from, def)
+}
+
+// Int64 converts the value wrapped by v, recording the first error.
+func (v Value) Int64() (to int64) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Int64(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// Int64Or is like Int64 but returns def without recording errors.
+func (v Value) Int64Or(def int64) int64 {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.Int64Or(v.from, def)
+}
+
+// String converts the value wrapped by v, recording the first error.
+func (v Value) String() (to string) {
+	if v.ok() {
+		var err error
+		to, err = v.c.String(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// StringOr is like String but returns def without recording errors.
+func (v Value) StringOr(def string) string {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.StringOr(v.from, def)
+}
+
+// Time converts the value wrapped by v, recording the first error.
+func (v Value) Time() (to time.Time) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Time(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// TimeOr is like Time but returns def without recording errors.
+func (v Value) TimeOr(def time.Time) time.Time {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.TimeOr(v.from, def)
+}
+
+// Uint converts the value wrapped by v, recording the first error.
+func (v Value) Uint() (to uint) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Uint(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// UintOr is like Uint but returns def without recording errors.
+func (v Value) UintOr(def uint) uint {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.UintOr(v.from, def)
+}
+
+// Uint8 converts the value wrapped by v, recording the first error.
+func (v Value) Uint8() (to uint8) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Uint8(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// Uint8Or is like Uint8 but returns def without recording errors.
+func (v Value) Uint8Or(def uint8) uint8 {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.Uint8Or(v.from, def)
+}
+
+// Uint16 converts the value wrapped by v, recording the first error.
+func (v Value) Uint16() (to uint16) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Uint16(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// Uint16Or is like Uint16 but returns def without recording errors.
+func (v Value) Uint16Or(def uint16) uint16 {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.Uint16Or(v.from, def)
+}
+
+// Uint32 converts the value wrapped by v, recording the first error.
+func (v Value) Uint32() (to uint32) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Uint32(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// Uint32Or is like Uint32 but returns def without recording errors.
+func (v Value) Uint32Or(def uint32) uint32 {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.Uint32Or(v.from, def)
+}
+
+// Uint64 converts the value wrapped by v, recording the first error.
+func (v Value) Uint64() (to uint64) {
+	if v.ok() {
+		var err error
+		to, err = v.c.Uint64(v.from)
+		v.record(err)
+	}
+	return
+}
+
+// Uint64Or is like Uint64 but returns def without recording errors.
+func (v Value) Uint64Or(def uint64) uint64 {
+	if v.fail != nil {
+		return def
+	}
+	return v.c.Uint64Or(v.from, def)
+}
@@ -0,0 +1,107 @@
+package testconv
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func RunComplex64Tests(t *testing.T, fn func(interface{}) (complex64, error)) {
+	RunTest(t, reflect.Complex64, func(v interface{}) (interface{}, error) {
+		return fn(v)
+	})
+}
+
+func RunComplex128Tests(t *testing.T, fn func(interface{}) (complex128, error)) {
+	RunTest(t, reflect.Complex128, func(v interface{}) (interface{}, error) {
+		return fn(v)
+	})
+}
+
+type testComplex128Converter complex128
+
+func (t testComplex128Converter) Complex128() (complex128, error) {
+	return complex128(t) + 5i, nil
+}
+
+func init() {
+	type ulyComplex64 complex64
+	type ulyComplex128 complex128
+
+	exp := func(e64 complex64, e128 complex128) []Expecter {
+		return []Expecter{Exp{e64}, Exp{e128}}
+	}
+	experrs := func(s string) []Expecter {
+		return []Expecter{experr(complex64(0), s), experr(complex128(0), s)}
+	}
+
+	// basics
+	assert(0, exp(0, 0))
+	assert(1, exp(1, 1))
+	assert(false, exp(0, 0))
+	assert(true, exp(1, 1))
+	assert("false", exp(0, 0))
+	assert("true", exp(1, 1))
+
+	// test length kinds
+	assert([]string{"one", "two"}, exp(2, 2))
+	assert(map[int]string{1: "one", 2: "two"}, exp(2, 2))
+
+	// test implements Complex128(complex128, error)
+	assert(testComplex128Converter(5), exp(5+5i, 5+5i))
+
+	// strings
+	assert("1+2i", exp(1+2i, 1+2i))
+	assert("(1-2i)", exp(1-2i, 1-2i))
+	assert("3i", exp(3i, 3i))
+	assert("-1.5", exp(-1.5, -1.5))
+	assert(testStringConverter("1+2i"), exp(1+2i, 1+2i))
+
+	// max bounds
+	assert(complex(math.MaxFloat64, math.MaxFloat64), exp(
+		complex(math.MaxFloat32, math.MaxFloat32),
+		complex(math.MaxFloat64, math.MaxFloat64)))
+	assert(complex(-math.MaxFloat64, -math.MaxFloat64), exp(
+		complex(-math.MaxFloat32, -math.MaxFloat32),
+		complex(-math.MaxFloat64, -math.MaxFloat64)))
+
+	// perms of various type
+	for i := -3; i < 3; i++ {
+		f := float32(i) / 2
+
+		// ints
+		assert(i, exp(complex(float32(i), 0), complex(float64(i), 0)))
+		assert(int8(i), exp(complex(float32(i), 0), complex(float64(i), 0)))
+		assert(int64(i), exp(complex(float32(i), 0), complex(float64(i), 0)))
+
+		// uints
+		if i > 0 {
+			assert(uint(i), exp(complex(float32(i), 0), complex(float64(i), 0)))
+			assert(uint64(i), exp(complex(float32(i), 0), complex(float64(i), 0)))
+		}
+
+		// floats
+		assert(f, exp(complex(f, 0), complex(float64(f), 0)))
+		assert(float64(f), exp(complex(f, 0), complex(float64(f), 0)))
+
+		// complex
+		assert(complex(f, f), exp(complex(f, f), complex(float64(f), float64(f))))
+		assert(complex(float64(f), float64(f)),
+			exp(complex(f, f), complex(float64(f), float64(f))))
+
+		// underlying
+		assert(ulyComplex64(complex(f, f)),
+			exp(complex(f, f), complex(float64(f), float64(f))))
+		assert(ulyComplex128(complex(f, f)),
+			exp(complex(f, f), complex(float64(f), float64(f))))
+
+		// from string
+		assert(fmt.Sprintf("%v", complex(f, f)),
+			exp(complex(f, f), complex(float64(f), float64(f))))
+	}
+
+	assert("foo", experrs(`cannot convert "foo" (type string) to `))
+	assert(struct{}{}, experrs(`cannot convert struct {}{} (type struct {}) to `))
+	assert(nil, experrs(`cannot convert <nil> (type <nil>) to `))
+}
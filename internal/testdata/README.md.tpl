@@ -42,6 +42,8 @@
 
 Package conv provides fast and intuitive conversions across Go types. This library uses reflection to be robust but will bypass it for common conversions, for example string conversion to any type will never use reflection. All functions are safe for concurrent use by multiple Goroutines.
 
+The generic functions `To`, `MustTo`, `ToOr`, `Number` and `NumberWith` require Go 1.21 or later, all other functions support the Go version in `go.mod`.
+
 {{ range Examples -}}
 ### {{ .Title }}
 
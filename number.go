@@ -0,0 +1,41 @@
+//go:build go1.21
+
+package conv
+
+import "github.com/cstockton/go-conv/internal/refconv"
+
+// Integer is a constraint satisfied by the integer types and any type derived
+// from them.
+type Integer = refconv.Integer
+
+// Float is a constraint satisfied by the float types and any type derived from
+// them.
+type Float = refconv.Float
+
+// Real is a constraint satisfied by the integer and float types.
+type Real = refconv.Real
+
+// Number will convert the given number to the numeric type To without the use
+// of reflection. Values beyond the range of To are clamped and fractions are
+// truncated in the same way as the function for that type, for example Int8 or
+// Uint32.
+//
+// Example:
+//
+//   i, err := conv.Number[int8](300)
+//   // i -> 127
+func Number[To, From Real](from From) (To, error) {
+	return refconv.Number[To](converter, from)
+}
+
+// NumberWith is like Number but uses the Rounding, NonFinite and Strict modes
+// of the given Converter.
+//
+// Example:
+//
+//   c := conv.Converter{Rounding: conv.RoundHalfEven}
+//   i, err := conv.NumberWith[int](c, 2.5)
+//   // i -> 2
+func NumberWith[To, From Real](c Converter, from From) (To, error) {
+	return refconv.Number[To](c, from)
+}
@@ -0,0 +1,240 @@
+// Code generated by go run gen.go. DO NOT EDIT.
+
+package conv
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/cstockton/go-conv/decimal"
+)
+
+// BigFloatOr is like BigFloat but returns def when the conversion fails.
+func BigFloatOr(from interface{}, def *big.Float) *big.Float {
+	return converter.BigFloatOr(from, def)
+}
+
+// MustBigFloat is like BigFloat but panics when the conversion fails.
+func MustBigFloat(from interface{}) *big.Float {
+	return converter.MustBigFloat(from)
+}
+
+// BigIntOr is like BigInt but returns def when the conversion fails.
+func BigIntOr(from interface{}, def *big.Int) *big.Int {
+	return converter.BigIntOr(from, def)
+}
+
+// MustBigInt is like BigInt but panics when the conversion fails.
+func MustBigInt(from interface{}) *big.Int {
+	return converter.MustBigInt(from)
+}
+
+// BigRatOr is like BigRat but returns def when the conversion fails.
+func BigRatOr(from interface{}, def *big.Rat) *big.Rat {
+	return converter.BigRatOr(from, def)
+}
+
+// MustBigRat is like BigRat but panics when the conversion fails.
+func MustBigRat(from interface{}) *big.Rat {
+	return converter.MustBigRat(from)
+}
+
+// BoolOr is like Bool but returns def when the conversion fails.
+func BoolOr(from interface{}, def bool) bool {
+	return converter.BoolOr(from, def)
+}
+
+// MustBool is like Bool but panics when the conversion fails.
+func MustBool(from interface{}) bool {
+	return converter.MustBool(from)
+}
+
+// BytesOr is like Bytes but returns def when the conversion fails.
+func BytesOr(from interface{}, def []byte) []byte {
+	return converter.BytesOr(from, def)
+}
+
+// MustBytes is like Bytes but panics when the conversion fails.
+func MustBytes(from interface{}) []byte {
+	return converter.MustBytes(from)
+}
+
+// Complex64Or is like Complex64 but returns def when the conversion fails.
+func Complex64Or(from interface{}, def complex64) complex64 {
+	return converter.Complex64Or(from, def)
+}
+
+// MustComplex64 is like Complex64 but panics when the conversion fails.
+func MustComplex64(from interface{}) complex64 {
+	return converter.MustComplex64(from)
+}
+
+// Complex128Or is like Complex128 but returns def when the conversion fails.
+func Complex128Or(from interface{}, def complex128) complex128 {
+	return converter.Complex128Or(from, def)
+}
+
+// MustComplex128 is like Complex128 but panics when the conversion fails.
+func MustComplex128(from interface{}) complex128 {
+	return converter.MustComplex128(from)
+}
+
+// DecimalOr is like Decimal but returns def when the conversion fails.
+func DecimalOr(from interface{}, def decimal.Decimal) decimal.Decimal {
+	return converter.DecimalOr(from, def)
+}
+
+// MustDecimal is like Decimal but panics when the conversion fails.
+func MustDecimal(from interface{}) decimal.Decimal {
+	return converter.MustDecimal(from)
+}
+
+// DurationOr is like Duration but returns def when the conversion fails.
+func DurationOr(from interface{}, def time.Duration) time.Duration {
+	return converter.DurationOr(from, def)
+}
+
+// MustDuration is like Duration but panics when the conversion fails.
+func MustDuration(from interface{}) time.Duration {
+	return converter.MustDuration(from)
+}
+
+// Float32Or is like Float32 but returns def when the conversion fails.
+func Float32Or(from interface{}, def float32) float32 {
+	return converter.Float32Or(from, def)
+}
+
+// MustFloat32 is like Float32 but panics when the conversion fails.
+func MustFloat32(from interface{}) float32 {
+	return converter.MustFloat32(from)
+}
+
+// Float64Or is like Float64 but returns def when the conversion fails.
+func Float64Or(from interface{}, def float64) float64 {
+	return converter.Float64Or(from, def)
+}
+
+// MustFloat64 is like Float64 but panics when the conversion fails.
+func MustFloat64(from interface{}) float64 {
+	return converter.MustFloat64(from)
+}
+
+// IntOr is like Int but returns def when the conversion fails.
+func IntOr(from interface{}, def int) int {
+	return converter.IntOr(from, def)
+}
+
+// MustInt is like Int but panics when the conversion fails.
+func MustInt(from interface{}) int {
+	return converter.MustInt(from)
+}
+
+// Int8Or is like Int8 but returns def when the conversion fails.
+func Int8Or(from interface{}, def int8) int8 {
+	return converter.Int8Or(from, def)
+}
+
+// MustInt8 is like Int8 but panics when the conversion fails.
+func MustInt8(from interface{}) int8 {
+	return converter.MustInt8(from)
+}
+
+// Int16Or is like Int16 but returns def when the conversion fails.
+func Int16Or(from interface{}, def int16) int16 {
+	return converter.Int16Or(from, def)
+}
+
+// MustInt16 is like Int16 but panics when the conversion fails.
+func MustInt16(from interface{}) int16 {
+	return converter.MustInt16(from)
+}
+
+// Int32Or is like Int32 but returns def when the conversion fails.
+func Int32Or(from interface{}, def int32) int32 {
+	return converter.Int32Or(from, def)
+}
+
+// MustInt32 is like Int32 but panics when the conversion fails.
+func MustInt32(from interface{}) int32 {
+	return converter.MustInt32(from)
+}
+
+// Int64Or is like Int64 but returns def when the conversion fails.
+func Int64Or(from interface{}, def int64) int64 {
+	return converter.Int64Or(from, def)
+}
+
+// MustInt64 is like Int64 but panics when the conversion fails.
+func MustInt64(from interface{}) int64 {
+	return converter.MustInt64(from)
+}
+
+// StringOr is like String but returns def when the conversion fails.
+func StringOr(from interface{}, def string) string {
+	return converter.StringOr(from, def)
+}
+
+// MustString is like String but panics when the conversion fails.
+func MustString(from interface{}) string {
+	return converter.MustString(from)
+}
+
+// TimeOr is like Time but returns def when the conversion fails.
+func TimeOr(from interface{}, def time.Time) time.Time {
+	return converter.TimeOr(from, def)
+}
+
+// MustTime is like Time but panics when the conversion fails.
+func MustTime(from interface{}) time.Time {
+	return converter.MustTime(from)
+}
+
+// UintOr is like Uint but returns def when the conversion fails.
+func UintOr(from interface{}, def uint) uint {
+	return converter.UintOr(from, def)
+}
+
+// MustUint is like Uint but panics when the conversion fails.
+func MustUint(from interface{}) uint {
+	return converter.MustUint(from)
+}
+
+// Uint8Or is like Uint8 but returns def when the conversion fails.
+func Uint8Or(from interface{}, def uint8) uint8 {
+	return converter.Uint8Or(from, def)
+}
+
+// MustUint8 is like Uint8 but panics when the conversion fails.
+func MustUint8(from interface{}) uint8 {
+	return converter.MustUint8(from)
+}
+
+// Uint16Or is like Uint16 but returns def when the conversion fails.
+func Uint16Or(from interface{}, def uint16) uint16 {
+	return converter.Uint16Or(from, def)
+}
+
+// MustUint16 is like Uint16 but panics when the conversion fails.
+func MustUint16(from interface{}) uint16 {
+	return converter.MustUint16(from)
+}
+
+// Uint32Or is like Uint32 but returns def when the conversion fails.
+func Uint32Or(from interface{}, def uint32) uint32 {
+	return converter.Uint32Or(from, def)
+}
+
+// MustUint32 is like Uint32 but panics when the conversion fails.
+func MustUint32(from interface{}) uint32 {
+	return converter.MustUint32(from)
+}
+
+// Uint64Or is like Uint64 but returns def when the conversion fails.
+func Uint64Or(from interface{}, def uint64) uint64 {
+	return converter.Uint64Or(from, def)
+}
+
+// MustUint64 is like Uint64 but panics when the conversion fails.
+func MustUint64(from interface{}) uint64 {
+	return converter.MustUint64(from)
+}